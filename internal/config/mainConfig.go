@@ -3,6 +3,7 @@ package config
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/caarlos0/env/v7"
 )
@@ -16,12 +17,31 @@ type MainConfig struct {
 	PostgresDB       string `env:"POSTGRES_DB,notEmpty" envDefault:"postgres"`
 	Port             string `env:"PORT,notEmpty" envDefault:"5000"`
 	Host             string `env:"HOST,notEmpty" envDefault:"localhost"`
+	MetricsPort      string `env:"METRICS_PORT,notEmpty" envDefault:"5001"`
 
 	PriceServicePort string `env:"PRICE_SERVICE_PORT,notEmpty" envDefault:"4000"`
 	PriceServiceHost string `env:"PRICE_SERVICE_HOST,notEmpty" envDefault:"localhost"`
 
+	PriceReconnectBackoffMax time.Duration `env:"PRICE_RECONNECT_BACKOFF_MAX,notEmpty" envDefault:"30s"`
+
 	PaymentServicePort string `env:"PAYMENT_SERVICE_PORT,notEmpty" envDefault:"2000"`
 	PaymentServiceHost string `env:"PAYMENT_SERVICE_HOST,notEmpty" envDefault:"localhost"`
+
+	MaxLeverage           float64 `env:"MAX_LEVERAGE,notEmpty" envDefault:"10"`
+	MaintenanceMarginRate float64 `env:"MAINTENANCE_MARGIN_RATE,notEmpty" envDefault:"0.05"`
+	LiquidationPolicy     string  `env:"LIQUIDATION_POLICY,notEmpty" envDefault:"worst_pnl"`
+
+	PaymentPollInterval      time.Duration `env:"PAYMENT_POLL_INTERVAL,notEmpty" envDefault:"1s"`
+	PaymentMaxAttempts       int           `env:"PAYMENT_MAX_ATTEMPTS,notEmpty" envDefault:"5"`
+	PaymentBackoffMax        time.Duration `env:"PAYMENT_BACKOFF_MAX,notEmpty" envDefault:"1m"`
+	PaymentReconcileInterval time.Duration `env:"PAYMENT_RECONCILE_INTERVAL,notEmpty" envDefault:"1h"`
+
+	FinancingInterval time.Duration `env:"FINANCING_INTERVAL,notEmpty" envDefault:"1m"`
+	FinancingRollover time.Duration `env:"FINANCING_ROLLOVER,notEmpty" envDefault:"22h"`
+
+	QuoteTTL time.Duration `env:"QUOTE_TTL,notEmpty" envDefault:"5s"`
+
+	IdempotencyTimeout time.Duration `env:"IDEMPOTENCY_TIMEOUT,notEmpty" envDefault:"1m"`
 }
 
 // NewMainConfig parsing config from environment
@@ -3,6 +3,8 @@ package handler
 
 import (
 	"context"
+	"crypto/sha256"
+	"errors"
 	"time"
 
 	"github.com/OVantsevich/Trading-Service/internal/model"
@@ -11,18 +13,40 @@ import (
 	"github.com/sirupsen/logrus"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 )
 
 // TradingService trading service
 //
 //go:generate mockery --name=TradingService --case=underscore --output=./mocks
 type TradingService interface {
-	CreatePosition(ctx context.Context, position *model.Position) (*model.Position, error)
+	CreatePosition(ctx context.Context, position *model.Position, slippage *model.Slippage) (*model.Position, error)
 	GetPositionByID(ctx context.Context, positionID string) (*model.Position, error)
 	GetUserPositions(ctx context.Context, userID string) ([]*model.Position, error)
+	ListPositions(ctx context.Context, filter *model.PositionFilter) ([]*model.Position, string, error)
+	GetPortfolioSummary(ctx context.Context, userID string) (*model.PortfolioSummary, error)
+	StreamPortfolio(ctx context.Context, userID string, send func(portfolio *model.Portfolio) error) error
+	SubscribeEvents(ctx context.Context, userID string, send func(event *model.Event) error) error
 	SetStopLoss(ctx context.Context, positionID string, stopLoss float64, updated time.Time) error
 	SetTakeProfit(ctx context.Context, positionID string, takeProfit float64, updated time.Time) error
-	ClosePosition(ctx context.Context, positionID string, closed int64, updated time.Time) error
+	SetTrailingStop(ctx context.Context, positionID string, trailingStop float64, percentage bool, updated time.Time) error
+	ClosePosition(ctx context.Context, positionID string, slippage *model.Slippage, closed int64, updated time.Time) error
+	PartialClosePosition(ctx context.Context, positionID string, amount float64, slippage *model.Slippage, updated time.Time) error
+	IncreasePosition(ctx context.Context, positionID string, amount float64, updated time.Time) (*model.Position, error)
+
+	PlaceLimitOrder(ctx context.Context, order *model.Order) (*model.Order, error)
+	CancelOrder(ctx context.Context, orderID string, updated time.Time) error
+	GetUserOrders(ctx context.Context, userID string) ([]*model.Order, error)
+
+	SetCommissionSchedule(ctx context.Context, schedule *model.CommissionSchedule) error
+	SetUserTier(ctx context.Context, userID, tier string) error
+	SetFinancingRate(ctx context.Context, rate *model.FinancingRate) error
+	SetRiskLimits(ctx context.Context, limits *model.RiskLimits) error
+
+	RequestQuote(ctx context.Context, quote *model.Quote) (*model.Quote, error)
+	ExecuteQuote(ctx context.Context, quoteID string) (*model.Position, error)
+
+	Idempotent(ctx context.Context, key, method string, requestHash []byte, call func() ([]byte, error)) ([]byte, error)
 }
 
 // Trading handler
@@ -38,36 +62,105 @@ func NewPrice(s TradingService) *Trading {
 
 // OpenPosition open new position
 func (t *Trading) OpenPosition(ctx context.Context, request *pr.OpenPositionRequest) (*pr.OpenPositionResponse, error) {
+	response := &pr.OpenPositionResponse{}
+	err := t.idempotent(ctx, request.GetIdempotencyKey(), "OpenPosition", request, response, func() (proto.Message, error) {
+		return t.openPosition(ctx, request)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (t *Trading) openPosition(ctx context.Context, request *pr.OpenPositionRequest) (*pr.OpenPositionResponse, error) {
+	_, bps := request.MaxSlippage.(*pr.OpenPositionRequest_MaxSlippageBps)
+	slippage := slippageFromGRPC(request.ExpectedPrice, request.GetMaxSlippageAbs(), request.GetMaxSlippageBps(), bps)
 	position, err := t.service.CreatePosition(ctx, &model.Position{
 		User:          request.UserID,
 		Name:          request.Name,
 		Amount:        request.Amount,
 		ShortPosition: request.ShortPosition,
-	})
+		Leverage:      request.GetLeverage(),
+		StopLoss:      request.GetStopLoss(),
+		TakeProfit:    request.GetTakeProfit(),
+		Created:       time.Now(),
+		Updated:       time.Now(),
+	}, slippage)
 	if err != nil {
 		logrus.WithFields(logrus.Fields{
 			"UserID":        request.UserID,
 			"Name":          request.Name,
 			"Amount":        request.Amount,
 			"ShortPosition": request.ShortPosition,
+			"Leverage":      request.GetLeverage(),
+			"StopLoss":      request.GetStopLoss(),
+			"TakeProfit":    request.GetTakeProfit(),
 		}).Errorf("trading - OpenPosition - CreatePosition: %v", err)
-		return nil, status.Error(codes.Unknown, err.Error())
+		return nil, positionError(err)
 	}
 	return &pr.OpenPositionResponse{Position: positionToGRPC(position)}, nil
 }
 
 // ClosePosition close position
 func (t *Trading) ClosePosition(ctx context.Context, request *pr.ClosePositionRequest) (*pr.Response, error) {
-	err := t.service.ClosePosition(ctx, request.PositionID, time.Now().Unix(), time.Now())
+	response := &pr.Response{}
+	err := t.idempotent(ctx, request.GetIdempotencyKey(), "ClosePosition", request, response, func() (proto.Message, error) {
+		return t.closePosition(ctx, request)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (t *Trading) closePosition(ctx context.Context, request *pr.ClosePositionRequest) (*pr.Response, error) {
+	_, bps := request.MaxSlippage.(*pr.ClosePositionRequest_MaxSlippageBps)
+	slippage := slippageFromGRPC(request.ExpectedPrice, request.GetMaxSlippageAbs(), request.GetMaxSlippageBps(), bps)
+	if request.Amount != nil {
+		err := t.service.PartialClosePosition(ctx, request.PositionID, *request.Amount, slippage, time.Now())
+		if err != nil {
+			logrus.WithFields(logrus.Fields{
+				"PositionID": request.PositionID,
+				"Amount":     *request.Amount,
+			}).Errorf("trading - ClosePosition - PartialClosePosition: %v", err)
+			return nil, positionError(err)
+		}
+		return &pr.Response{}, nil
+	}
+	err := t.service.ClosePosition(ctx, request.PositionID, slippage, time.Now().Unix(), time.Now())
 	if err != nil {
 		logrus.WithFields(logrus.Fields{
 			"PositionID": request.PositionID,
 		}).Errorf("trading - ClosePosition - ClosePosition: %v", err)
-		return nil, status.Error(codes.Unknown, err.Error())
+		return nil, positionError(err)
 	}
 	return &pr.Response{}, nil
 }
 
+// IncreasePosition buy more of open position
+func (t *Trading) IncreasePosition(ctx context.Context, request *pr.IncreasePositionRequest) (*pr.IncreasePositionResponse, error) {
+	response := &pr.IncreasePositionResponse{}
+	err := t.idempotent(ctx, request.GetIdempotencyKey(), "IncreasePosition", request, response, func() (proto.Message, error) {
+		return t.increasePosition(ctx, request)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (t *Trading) increasePosition(ctx context.Context, request *pr.IncreasePositionRequest) (*pr.IncreasePositionResponse, error) {
+	position, err := t.service.IncreasePosition(ctx, request.PositionID, request.Amount, time.Now())
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"PositionID": request.PositionID,
+			"Amount":     request.Amount,
+		}).Errorf("trading - IncreasePosition - IncreasePosition: %v", err)
+		return nil, positionError(err)
+	}
+	return &pr.IncreasePositionResponse{Position: positionToGRPC(position)}, nil
+}
+
 // GetPositionByID get position by id
 func (t *Trading) GetPositionByID(ctx context.Context, request *pr.GetPositionByIDRequest) (*pr.GetPositionByIDResponse, error) {
 	position, err := t.service.GetPositionByID(ctx, request.PositionID)
@@ -96,6 +189,107 @@ func (t *Trading) GetUserPositions(ctx context.Context, request *pr.GetUserPosit
 	return &pr.GetUserPositionsResponse{Position: resPos}, nil
 }
 
+// ListPositions get filtered page of user positions
+func (t *Trading) ListPositions(ctx context.Context, request *pr.ListPositionsRequest) (*pr.ListPositionsResponse, error) {
+	filter := &model.PositionFilter{
+		User:       request.UserID,
+		Open:       request.Open,
+		Name:       request.GetName(),
+		ClosedFrom: request.GetClosedFrom(),
+		ClosedTo:   request.GetClosedTo(),
+		Short:      request.ShortPosition,
+		Descending: request.Descending,
+		Limit:      int(request.Limit),
+		Cursor:     request.GetCursor(),
+	}
+	if request.CreatedFrom != nil {
+		filter.CreatedFrom = time.Unix(*request.CreatedFrom, 0)
+	}
+	if request.CreatedTo != nil {
+		filter.CreatedTo = time.Unix(*request.CreatedTo, 0)
+	}
+	positions, next, err := t.service.ListPositions(ctx, filter)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"UserID": request.UserID,
+			"Cursor": request.GetCursor(),
+		}).Errorf("trading - ListPositions - ListPositions: %v", err)
+		if errors.Is(err, model.ErrInvalidCursor) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, status.Error(codes.Unknown, err.Error())
+	}
+	response := &pr.ListPositionsResponse{Position: make([]*pr.Position, len(positions))}
+	for i, p := range positions {
+		response.Position[i] = positionToGRPC(p)
+	}
+	if next != "" {
+		response.NextCursor = &next
+	}
+	return response, nil
+}
+
+// GetPortfolioSummary get pnl and exposure of user positions
+func (t *Trading) GetPortfolioSummary(ctx context.Context, request *pr.GetPortfolioSummaryRequest) (*pr.GetPortfolioSummaryResponse, error) {
+	summary, err := t.service.GetPortfolioSummary(ctx, request.UserID)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"UserID": request.UserID,
+		}).Errorf("trading - GetPortfolioSummary - GetPortfolioSummary: %v", err)
+		return nil, status.Error(codes.Unknown, err.Error())
+	}
+	response := &pr.GetPortfolioSummaryResponse{
+		OpenPositions: int32(summary.OpenPositions),
+		RealizedPnl:   summary.RealizedPNL,
+		UnrealizedPnl: summary.UnrealizedPNL,
+		GrossExposure: summary.GrossExposure,
+		NetExposure:   summary.NetExposure,
+		Instruments:   make([]*pr.InstrumentSummary, len(summary.Instruments)),
+	}
+	for i, instrument := range summary.Instruments {
+		response.Instruments[i] = &pr.InstrumentSummary{
+			Name:          instrument.Name,
+			OpenPositions: int32(instrument.OpenPositions),
+			RealizedPnl:   instrument.RealizedPNL,
+			UnrealizedPnl: instrument.UnrealizedPNL,
+			GrossExposure: instrument.GrossExposure,
+			NetExposure:   instrument.NetExposure,
+		}
+	}
+	return response, nil
+}
+
+// StreamPortfolio stream open positions of user valued at mark price on every relevant price tick
+func (t *Trading) StreamPortfolio(request *pr.StreamPortfolioRequest, stream pr.TradingService_StreamPortfolioServer) error {
+	err := t.service.StreamPortfolio(stream.Context(), request.UserID, func(portfolio *model.Portfolio) error {
+		return stream.Send(portfolioToGRPC(portfolio))
+	})
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"UserID": request.UserID,
+		}).Errorf("trading - StreamPortfolio - StreamPortfolio: %v", err)
+		return status.Error(codes.Unknown, err.Error())
+	}
+	return nil
+}
+
+// SubscribeEvents stream position and order events of user
+func (t *Trading) SubscribeEvents(request *pr.SubscribeEventsRequest, stream pr.TradingService_SubscribeEventsServer) error {
+	err := t.service.SubscribeEvents(stream.Context(), request.UserID, func(event *model.Event) error {
+		return stream.Send(eventToGRPC(event))
+	})
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"UserID": request.UserID,
+		}).Errorf("trading - SubscribeEvents - SubscribeEvents: %v", err)
+		if errors.Is(err, model.ErrEventsOverflow) {
+			return status.Error(codes.ResourceExhausted, err.Error())
+		}
+		return status.Error(codes.Unknown, err.Error())
+	}
+	return nil
+}
+
 // StopLoss set stop loss
 func (t *Trading) StopLoss(ctx context.Context, request *pr.StopLossRequest) (*pr.Response, error) {
 	err := t.service.SetStopLoss(ctx, request.PositionID, request.Price, time.Now())
@@ -120,15 +314,316 @@ func (t *Trading) TakeProfit(ctx context.Context, request *pr.TakeProfitRequest)
 	return &pr.Response{}, nil
 }
 
+// TrailingStop set trailing stop
+func (t *Trading) TrailingStop(ctx context.Context, request *pr.TrailingStopRequest) (*pr.Response, error) {
+	err := t.service.SetTrailingStop(ctx, request.PositionID, request.Distance, request.Percentage, time.Now())
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"PositionID": request.PositionID,
+			"Distance":   request.Distance,
+			"Percentage": request.Percentage,
+		}).Errorf("trading - TrailingStop - SetTrailingStop: %v", err)
+		return nil, status.Error(codes.Unknown, err.Error())
+	}
+	return &pr.Response{}, nil
+}
+
+// PlaceLimitOrder place limit order
+func (t *Trading) PlaceLimitOrder(ctx context.Context, request *pr.PlaceLimitOrderRequest) (*pr.PlaceLimitOrderResponse, error) {
+	response := &pr.PlaceLimitOrderResponse{}
+	err := t.idempotent(ctx, request.GetIdempotencyKey(), "PlaceLimitOrder", request, response, func() (proto.Message, error) {
+		return t.placeLimitOrder(ctx, request)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (t *Trading) placeLimitOrder(ctx context.Context, request *pr.PlaceLimitOrderRequest) (*pr.PlaceLimitOrderResponse, error) {
+	order, err := t.service.PlaceLimitOrder(ctx, &model.Order{
+		User:          request.UserID,
+		Name:          request.Name,
+		Amount:        request.Amount,
+		Price:         request.Price,
+		ShortPosition: request.ShortPosition,
+	})
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"UserID":        request.UserID,
+			"Name":          request.Name,
+			"Amount":        request.Amount,
+			"Price":         request.Price,
+			"ShortPosition": request.ShortPosition,
+		}).Errorf("trading - PlaceLimitOrder - PlaceLimitOrder: %v", err)
+		if errors.Is(err, model.ErrInvalidOrder) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, status.Error(codes.Unknown, err.Error())
+	}
+	return &pr.PlaceLimitOrderResponse{Order: orderToGRPC(order)}, nil
+}
+
+// CancelOrder cancel pending order
+func (t *Trading) CancelOrder(ctx context.Context, request *pr.CancelOrderRequest) (*pr.Response, error) {
+	response := &pr.Response{}
+	err := t.idempotent(ctx, request.GetIdempotencyKey(), "CancelOrder", request, response, func() (proto.Message, error) {
+		return t.cancelOrder(ctx, request)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (t *Trading) cancelOrder(ctx context.Context, request *pr.CancelOrderRequest) (*pr.Response, error) {
+	err := t.service.CancelOrder(ctx, request.OrderID, time.Now())
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"OrderID": request.OrderID,
+		}).Errorf("trading - CancelOrder - CancelOrder: %v", err)
+		return nil, status.Error(codes.Unknown, err.Error())
+	}
+	return &pr.Response{}, nil
+}
+
+// GetUserOrders get orders by user id
+func (t *Trading) GetUserOrders(ctx context.Context, request *pr.GetUserOrdersRequest) (*pr.GetUserOrdersResponse, error) {
+	orders, err := t.service.GetUserOrders(ctx, request.UserID)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"UserID": request.UserID,
+		}).Errorf("trading - GetUserOrders - GetUserOrders: %v", err)
+		return nil, status.Error(codes.Unknown, err.Error())
+	}
+	resOrd := make([]*pr.Order, len(orders))
+	for i, o := range orders {
+		resOrd[i] = orderToGRPC(o)
+	}
+	return &pr.GetUserOrdersResponse{Order: resOrd}, nil
+}
+
+// SetCommissionSchedule set commission of instrument and user tier
+func (t *Trading) SetCommissionSchedule(ctx context.Context, request *pr.SetCommissionScheduleRequest) (*pr.Response, error) {
+	err := t.service.SetCommissionSchedule(ctx, &model.CommissionSchedule{
+		Name:  request.GetName(),
+		Tier:  request.GetTier(),
+		Kind:  request.Kind,
+		Value: request.Value,
+	})
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"Name": request.GetName(),
+			"Tier": request.GetTier(),
+		}).Errorf("trading - SetCommissionSchedule - SetCommissionSchedule: %v", err)
+		if errors.Is(err, model.ErrInvalidCommission) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, status.Error(codes.Unknown, err.Error())
+	}
+	return &pr.Response{}, nil
+}
+
+// SetUserTier set commission tier of user
+func (t *Trading) SetUserTier(ctx context.Context, request *pr.SetUserTierRequest) (*pr.Response, error) {
+	err := t.service.SetUserTier(ctx, request.UserID, request.Tier)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"UserID": request.UserID,
+			"Tier":   request.Tier,
+		}).Errorf("trading - SetUserTier - SetUserTier: %v", err)
+		return nil, status.Error(codes.Unknown, err.Error())
+	}
+	return &pr.Response{}, nil
+}
+
+// SetFinancingRate set daily financing rates of instrument
+func (t *Trading) SetFinancingRate(ctx context.Context, request *pr.SetFinancingRateRequest) (*pr.Response, error) {
+	err := t.service.SetFinancingRate(ctx, &model.FinancingRate{
+		Name:      request.Name,
+		LongRate:  request.LongRate,
+		ShortRate: request.ShortRate,
+	})
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"Name": request.Name,
+		}).Errorf("trading - SetFinancingRate - SetFinancingRate: %v", err)
+		if errors.Is(err, model.ErrInvalidFinancingRate) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, status.Error(codes.Unknown, err.Error())
+	}
+	return &pr.Response{}, nil
+}
+
+// SetRiskLimits set limits of user for all instruments or for one of them
+func (t *Trading) SetRiskLimits(ctx context.Context, request *pr.SetRiskLimitsRequest) (*pr.Response, error) {
+	err := t.service.SetRiskLimits(ctx, &model.RiskLimits{
+		User:             request.UserID,
+		Name:             request.GetName(),
+		MaxOpenPositions: int(request.MaxOpenPositions),
+		MaxNotional:      request.MaxNotional,
+		MaxExposure:      request.MaxExposure,
+		MaxShortExposure: request.MaxShortExposure,
+	})
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"UserID": request.UserID,
+			"Name":   request.GetName(),
+		}).Errorf("trading - SetRiskLimits - SetRiskLimits: %v", err)
+		if errors.Is(err, model.ErrInvalidRiskLimits) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, status.Error(codes.Unknown, err.Error())
+	}
+	return &pr.Response{}, nil
+}
+
+// RequestQuote lock current price of instrument for a short time
+func (t *Trading) RequestQuote(ctx context.Context, request *pr.RequestQuoteRequest) (*pr.RequestQuoteResponse, error) {
+	quote, err := t.service.RequestQuote(ctx, &model.Quote{
+		User:          request.UserID,
+		Name:          request.Name,
+		Amount:        request.Amount,
+		ShortPosition: request.ShortPosition,
+	})
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"UserID":        request.UserID,
+			"Name":          request.Name,
+			"Amount":        request.Amount,
+			"ShortPosition": request.ShortPosition,
+		}).Errorf("trading - RequestQuote - RequestQuote: %v", err)
+		return nil, positionError(err)
+	}
+	return &pr.RequestQuoteResponse{Quote: quoteToGRPC(quote)}, nil
+}
+
+// ExecuteQuote open position at price locked by quote
+func (t *Trading) ExecuteQuote(ctx context.Context, request *pr.ExecuteQuoteRequest) (*pr.ExecuteQuoteResponse, error) {
+	response := &pr.ExecuteQuoteResponse{}
+	err := t.idempotent(ctx, request.GetIdempotencyKey(), "ExecuteQuote", request, response, func() (proto.Message, error) {
+		return t.executeQuote(ctx, request)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (t *Trading) executeQuote(ctx context.Context, request *pr.ExecuteQuoteRequest) (*pr.ExecuteQuoteResponse, error) {
+	position, err := t.service.ExecuteQuote(ctx, request.QuoteID)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"QuoteID": request.QuoteID,
+		}).Errorf("trading - ExecuteQuote - ExecuteQuote: %v", err)
+		switch {
+		case errors.Is(err, model.ErrQuoteNotFound):
+			return nil, status.Error(codes.NotFound, err.Error())
+		case errors.Is(err, model.ErrQuoteExpired) || errors.Is(err, model.ErrQuoteUsed):
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		default:
+			return nil, positionError(err)
+		}
+	}
+	return &pr.ExecuteQuoteResponse{Position: positionToGRPC(position)}, nil
+}
+
+// idempotent execute call once per idempotency key and fill response with its result or with the stored one
+func (t *Trading) idempotent(ctx context.Context, key, method string, request, response proto.Message, call func() (proto.Message, error)) error {
+	if key == "" {
+		res, err := call()
+		if err != nil {
+			return err
+		}
+		proto.Merge(response, res)
+		return nil
+	}
+
+	req, err := proto.MarshalOptions{Deterministic: true}.Marshal(request)
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	hash := sha256.Sum256(req)
+	data, err := t.service.Idempotent(ctx, key, method, hash[:], func() ([]byte, error) {
+		res, callErr := call()
+		if callErr != nil {
+			return nil, callErr
+		}
+		return proto.Marshal(res)
+	})
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return err
+		}
+		logrus.WithFields(logrus.Fields{
+			"Key":    key,
+			"Method": method,
+		}).Errorf("trading - idempotent - Idempotent: %v", err)
+		switch {
+		case errors.Is(err, model.ErrRequestInProgress):
+			return status.Error(codes.Aborted, err.Error())
+		case errors.Is(err, model.ErrIdempotencyKeyReused):
+			return status.Error(codes.InvalidArgument, err.Error())
+		default:
+			return status.Error(codes.Unknown, err.Error())
+		}
+	}
+	return proto.Unmarshal(data, response)
+}
+
+// positionError reject change of position which is being closed or already closed, which price slipped too far,
+// which breaches risk limits or which can't be watched while prices are stale
+func positionError(err error) error {
+	if errors.Is(err, model.ErrPositionClosing) || errors.Is(err, model.ErrPositionClosed) || errors.Is(err, model.ErrSlippageExceeded) {
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	if errors.Is(err, model.ErrRiskLimit) {
+		return status.Error(codes.ResourceExhausted, err.Error())
+	}
+	if errors.Is(err, model.ErrPricesStale) {
+		return status.Error(codes.Unavailable, err.Error())
+	}
+	return status.Error(codes.Unknown, err.Error())
+}
+
+// slippageFromGRPC slippage of request, nil if client doesn't expect any price
+func slippageFromGRPC(expectedPrice *float64, maxSlippageAbs, maxSlippageBPS float64, bps bool) *model.Slippage {
+	if expectedPrice == nil {
+		return nil
+	}
+	if bps {
+		return &model.Slippage{ExpectedPrice: *expectedPrice, MaxSlippage: maxSlippageBPS, BPS: true}
+	}
+	return &model.Slippage{ExpectedPrice: *expectedPrice, MaxSlippage: maxSlippageAbs}
+}
+
 func positionToGRPC(pos *model.Position) *pr.Position {
 	prPos := &pr.Position{
 		Id:            pos.ID,
 		Name:          pos.Name,
 		Amount:        pos.Amount,
 		Closed:        pos.Closed,
+		Status:        pos.Status,
 		ShortPosition: pos.ShortPosition,
 		SellingPrice:  pos.SellingPrice,
 		PurchasePrice: pos.PurchasePrice,
+
+		Leverage:          pos.Leverage,
+		Margin:            pos.Margin,
+		MaintenanceMargin: pos.MaintenanceMargin,
+		RealizedPnl:       pos.RealizedPNL,
+		Fees:              pos.Fees,
+		AccruedFinancing:  pos.AccruedFinancing,
+	}
+	if pos.LiquidationReason != "" {
+		prPos.LiquidationReason = &pos.LiquidationReason
+	}
+	if pos.CloseReason != "" {
+		prPos.CloseReason = &pos.CloseReason
+	}
+	if pos.TriggerPrice != 0 {
+		prPos.TriggerPrice = &pos.TriggerPrice
 	}
 	if pos.StopLoss != 0 {
 		prPos.StopLoss = &pos.StopLoss
@@ -136,6 +631,13 @@ func positionToGRPC(pos *model.Position) *pr.Position {
 	if pos.TakeProfit != 0 {
 		prPos.TakeProfit = &pos.TakeProfit
 	}
+	if pos.TrailingStop != 0 {
+		prPos.TrailingStop = &pos.TrailingStop
+		prPos.TrailingPercentage = pos.TrailingPercentage
+	}
+	if pos.TrailingLevel != 0 {
+		prPos.TrailingLevel = &pos.TrailingLevel
+	}
 	if !pos.Created.IsZero() {
 		createUnix := pos.Created.Unix()
 		prPos.Created = &createUnix
@@ -149,9 +651,26 @@ func positionFromGRPC(pos *pr.Position) *model.Position {
 		Name:          pos.Name,
 		Amount:        pos.Amount,
 		Closed:        pos.Closed,
+		Status:        pos.Status,
 		ShortPosition: pos.ShortPosition,
 		SellingPrice:  pos.SellingPrice,
 		PurchasePrice: pos.PurchasePrice,
+
+		Leverage:          pos.Leverage,
+		Margin:            pos.Margin,
+		MaintenanceMargin: pos.MaintenanceMargin,
+		RealizedPNL:       pos.RealizedPnl,
+		Fees:              pos.Fees,
+		AccruedFinancing:  pos.AccruedFinancing,
+	}
+	if pos.LiquidationReason != nil {
+		modelPos.LiquidationReason = *pos.LiquidationReason
+	}
+	if pos.CloseReason != nil {
+		modelPos.CloseReason = *pos.CloseReason
+	}
+	if pos.TriggerPrice != nil {
+		modelPos.TriggerPrice = *pos.TriggerPrice
 	}
 	if pos.StopLoss != nil {
 		modelPos.StopLoss = *pos.StopLoss
@@ -159,9 +678,91 @@ func positionFromGRPC(pos *pr.Position) *model.Position {
 	if pos.TakeProfit != nil {
 		modelPos.TakeProfit = *pos.TakeProfit
 	}
+	if pos.TrailingStop != nil {
+		modelPos.TrailingStop = *pos.TrailingStop
+		modelPos.TrailingPercentage = pos.TrailingPercentage
+	}
+	if pos.TrailingLevel != nil {
+		modelPos.TrailingLevel = *pos.TrailingLevel
+	}
 	if pos.Created != nil {
 		created := time.Unix(*pos.Created, 0)
 		modelPos.Created = created
 	}
 	return modelPos
 }
+
+func portfolioToGRPC(portfolio *model.Portfolio) *pr.StreamPortfolioResponse {
+	prPortfolio := &pr.StreamPortfolioResponse{
+		Positions: make([]*pr.PositionPNL, len(portfolio.Positions)),
+		Balance:   portfolio.Balance,
+		Equity:    portfolio.Equity,
+		Updated:   portfolio.Updated.Unix(),
+	}
+	for i, p := range portfolio.Positions {
+		prPortfolio.Positions[i] = &pr.PositionPNL{
+			Position:      positionToGRPC(p.Position),
+			MarkPrice:     p.MarkPrice,
+			UnrealizedPnl: p.UnrealizedPNL,
+			Equity:        p.Equity,
+		}
+	}
+	return prPortfolio
+}
+
+// eventTypes proto types of events
+var eventTypes = map[string]pr.EventType{
+	model.EventCreated:       pr.EventType_POSITION_CREATED,
+	model.EventAmountChanged: pr.EventType_POSITION_AMOUNT_CHANGED,
+	model.EventStopLoss:      pr.EventType_STOP_LOSS_SET,
+	model.EventTakeProfit:    pr.EventType_TAKE_PROFIT_SET,
+	model.EventTrailingStop:  pr.EventType_TRAILING_STOP_SET,
+	model.EventClosed:        pr.EventType_POSITION_CLOSED,
+	model.EventOrderPlaced:   pr.EventType_ORDER_PLACED,
+	model.EventOrderClosed:   pr.EventType_ORDER_CLOSED,
+}
+
+func eventToGRPC(event *model.Event) *pr.Event {
+	prEvent := &pr.Event{
+		Type:    eventTypes[event.Type],
+		Created: event.Created.Unix(),
+	}
+	switch {
+	case event.Order != nil:
+		prEvent.Payload = &pr.Event_Order{Order: orderToGRPC(event.Order)}
+	case event.Position != nil:
+		prEvent.Payload = &pr.Event_Position{Position: positionToGRPC(event.Position)}
+	}
+	return prEvent
+}
+
+func quoteToGRPC(quote *model.Quote) *pr.Quote {
+	return &pr.Quote{
+		Id:            quote.ID,
+		Name:          quote.Name,
+		Amount:        quote.Amount,
+		ShortPosition: quote.ShortPosition,
+		PurchasePrice: quote.PurchasePrice,
+		SellingPrice:  quote.SellingPrice,
+		ExpiresMs:     quote.Expires.UnixMilli(),
+	}
+}
+
+func orderToGRPC(order *model.Order) *pr.Order {
+	prOrd := &pr.Order{
+		Id:            order.ID,
+		Name:          order.Name,
+		Amount:        order.Amount,
+		Price:         order.Price,
+		ShortPosition: order.ShortPosition,
+		Status:        order.Status,
+	}
+	if order.PositionID != "" {
+		prOrd.PositionID = &order.PositionID
+	}
+	if !order.Created.IsZero() {
+		createUnix := order.Created.Unix()
+		prOrd.Created = &createUnix
+	}
+	return prOrd
+}
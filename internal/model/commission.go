@@ -0,0 +1,35 @@
+// Package model commission model
+package model
+
+import (
+	"errors"
+	"time"
+)
+
+// CommissionFlat fixed commission per trade
+const CommissionFlat = "flat"
+
+// CommissionPercentage commission in percents of trade notional
+const CommissionPercentage = "percentage"
+
+// ErrInvalidCommission commission schedule with unknown kind or negative value
+var ErrInvalidCommission = errors.New("commission must be flat or percentage and not negative")
+
+// CommissionSchedule commission of trades in instrument by users of tier,
+// empty name or tier matches any instrument or tier
+type CommissionSchedule struct {
+	ID      string    `json:"id"`
+	Name    string    `json:"name"`
+	Tier    string    `json:"tier"`
+	Kind    string    `json:"kind"`
+	Value   float64   `json:"value"`
+	Created time.Time `json:"created"`
+}
+
+// Fee commission of trade with given notional
+func (s *CommissionSchedule) Fee(notional float64) float64 {
+	if s.Kind == CommissionPercentage {
+		return notional * s.Value / 100
+	}
+	return s.Value
+}
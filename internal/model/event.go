@@ -0,0 +1,43 @@
+// Package model event model
+package model
+
+import (
+	"errors"
+	"time"
+)
+
+// EventCreated position is opened
+const EventCreated = "created"
+
+// EventAmountChanged position is increased or partially closed
+const EventAmountChanged = "amount_changed"
+
+// EventStopLoss stop loss of position is set
+const EventStopLoss = "stop_loss"
+
+// EventTakeProfit take profit of position is set
+const EventTakeProfit = "take_profit"
+
+// EventTrailingStop trailing stop of position is set or removed
+const EventTrailingStop = "trailing_stop"
+
+// EventClosed position is closed, close reason and trigger price tell what closed it
+const EventClosed = "closed"
+
+// EventOrderPlaced limit order is placed
+const EventOrderPlaced = "order_placed"
+
+// EventOrderClosed limit order is filled, canceled or failed
+const EventOrderClosed = "order_closed"
+
+// ErrEventsOverflow subscriber didn't keep up with events and was unsubscribed
+var ErrEventsOverflow = errors.New("events subscriber fell behind, events were dropped")
+
+// Event change of user position or order
+type Event struct {
+	Type     string    `json:"type"`
+	User     string    `json:"user"`
+	Position *Position `json:"position"`
+	Order    *Order    `json:"order"`
+	Created  time.Time `json:"created"`
+}
@@ -0,0 +1,13 @@
+// Package model fill model
+package model
+
+import "time"
+
+// Fill realized part of position closed at price
+type Fill struct {
+	ID         string    `json:"id"`
+	PositionID string    `json:"position_id"`
+	Amount     float64   `json:"amount"`
+	Price      float64   `json:"price"`
+	Created    time.Time `json:"created"`
+}
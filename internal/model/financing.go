@@ -0,0 +1,40 @@
+// Package model financing model
+package model
+
+import (
+	"errors"
+	"time"
+)
+
+// ErrInvalidFinancingRate negative financing rate
+var ErrInvalidFinancingRate = errors.New("financing rates must not be negative")
+
+// FinancingRate daily financing of positions in instrument in percents of their notional,
+// long positions pay swap rate and short positions pay borrow rate
+type FinancingRate struct {
+	Name      string    `json:"name"`
+	LongRate  float64   `json:"long_rate"`
+	ShortRate float64   `json:"short_rate"`
+	Updated   time.Time `json:"updated"`
+}
+
+// Rate daily rate paid by position
+func (r *FinancingRate) Rate(position *Position) float64 {
+	if position.ShortPosition {
+		return r.ShortRate
+	}
+	return r.LongRate
+}
+
+// FinancingAccrual financing charged from position for one rollover
+type FinancingAccrual struct {
+	ID         string    `json:"id"`
+	PositionID string    `json:"position_id"`
+	User       string    `json:"user"`
+	Name       string    `json:"name"`
+	Rollover   time.Time `json:"rollover"`
+	Rate       float64   `json:"rate"`
+	Notional   float64   `json:"notional"`
+	Amount     float64   `json:"amount"`
+	Created    time.Time `json:"created"`
+}
@@ -0,0 +1,22 @@
+// Package model idempotency model
+package model
+
+import (
+	"errors"
+	"time"
+)
+
+// ErrRequestInProgress request with the same idempotency key is still executing
+var ErrRequestInProgress = errors.New("request with this idempotency key is in progress")
+
+// ErrIdempotencyKeyReused idempotency key was used for another request
+var ErrIdempotencyKeyReused = errors.New("idempotency key was used for another request")
+
+// IdempotencyKey key of mutating request with its stored response, response is nil while request is in progress
+type IdempotencyKey struct {
+	Key         string    `json:"key"`
+	Method      string    `json:"method"`
+	RequestHash []byte    `json:"request_hash"`
+	Response    []byte    `json:"response"`
+	Created     time.Time `json:"created"`
+}
@@ -1,8 +1,10 @@
 // Package model notification model
 package model
 
-// Notification notify from postgres SL or TP
+// Notification notify from postgres SL, TP or limit order
 type Notification struct {
 	*Position
-	Type string `json:"type"`
+	Order *Order `json:"order"`
+	Type  string `json:"type"`
+	Xmin  string `json:"xmin"` // oldest transaction running when notification was sent
 }
@@ -0,0 +1,44 @@
+// Package model order model
+package model
+
+import (
+	"errors"
+	"time"
+)
+
+// ErrInvalidOrder order without positive amount or limit price
+var ErrInvalidOrder = errors.New("order amount and price must be positive")
+
+// OrderPending order is waiting for the limit price
+const OrderPending = "pending"
+
+// OrderFilled order opened a position
+const OrderFilled = "filled"
+
+// OrderCanceled order canceled by user
+const OrderCanceled = "canceled"
+
+// OrderFailed order crossed the limit price but position was not opened
+const OrderFailed = "failed"
+
+// Order pending limit order
+type Order struct {
+	ID            string    `json:"id"`
+	User          string    `json:"user"`
+	Name          string    `json:"name"`
+	Amount        float64   `json:"amount"`
+	Price         float64   `json:"price"`
+	ShortPosition bool      `json:"short_position"`
+	Status        string    `json:"status"`
+	PositionID    string    `json:"position_id"`
+	Created       time.Time `json:"created"`
+	Updated       time.Time `json:"updated"`
+}
+
+// Reached long order is filled when purchase price falls to the limit, short order when it rises to the limit
+func (o *Order) Reached(price *Price) bool {
+	if o.ShortPosition {
+		return price.PurchasePrice >= o.Price
+	}
+	return price.PurchasePrice <= o.Price
+}
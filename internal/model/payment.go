@@ -0,0 +1,59 @@
+// Package model payment command model
+package model
+
+import "time"
+
+// PaymentIncrease credit user account
+const PaymentIncrease = "increase"
+
+// PaymentDecrease debit user account
+const PaymentDecrease = "decrease"
+
+// PaymentOpen margin debited for opened position
+const PaymentOpen = "open"
+
+// PaymentScale amount debited for increased position
+const PaymentScale = "scale"
+
+// PaymentSettle funds settled for closed part of position
+const PaymentSettle = "settle"
+
+// PaymentFinancing overnight financing debited for held position
+const PaymentFinancing = "financing"
+
+// PaymentPending command is waiting for execution
+const PaymentPending = "pending"
+
+// PaymentInFlight command is being executed by payment service
+const PaymentInFlight = "in_flight"
+
+// PaymentDone command executed
+const PaymentDone = "done"
+
+// PaymentFailed command failed permanently, compensation applied
+const PaymentFailed = "failed"
+
+// PaymentReconcile settlement, financing or increase which can't be reverted failed permanently,
+// it is retried once per reconciliation interval
+const PaymentReconcile = "reconcile"
+
+// PaymentCanceled command canceled by compensation of previous command
+const PaymentCanceled = "canceled"
+
+// PaymentCommand outbox command to payment service
+type PaymentCommand struct {
+	ID          string    `json:"id"`
+	Seq         int64     `json:"seq"`
+	PositionID  string    `json:"position_id"`
+	User        string    `json:"user"`
+	Operation   string    `json:"operation"`
+	Amount      float64   `json:"amount"`
+	Reason      string    `json:"reason"`
+	Snapshot    *Position `json:"snapshot"` // position before increase, Updated is the time increase was applied
+	Status      string    `json:"status"`
+	Attempts    int       `json:"attempts"`
+	LastError   string    `json:"last_error"`
+	NextAttempt time.Time `json:"next_attempt"`
+	Created     time.Time `json:"created"`
+	Updated     time.Time `json:"updated"`
+}
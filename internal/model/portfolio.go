@@ -0,0 +1,43 @@
+// Package model portfolio model
+package model
+
+import "time"
+
+// PositionPNL open position valued at mark price
+type PositionPNL struct {
+	Position      *Position `json:"position"`
+	MarkPrice     float64   `json:"mark_price"`
+	UnrealizedPNL float64   `json:"unrealized_pnl"`
+	Equity        float64   `json:"equity"`
+}
+
+// Portfolio open positions of user with free balance, equity is balance with equity of all positions
+type Portfolio struct {
+	User      string         `json:"user"`
+	Positions []*PositionPNL `json:"positions"`
+	Balance   float64        `json:"balance"`
+	Equity    float64        `json:"equity"`
+	Updated   time.Time      `json:"updated"`
+}
+
+// InstrumentSummary pnl and exposure of user positions in one instrument,
+// exposure is notional of open positions at mark price, net exposure is long minus short
+type InstrumentSummary struct {
+	Name          string  `json:"name"`
+	OpenPositions int     `json:"open_positions"`
+	RealizedPNL   float64 `json:"realized_pnl"`
+	UnrealizedPNL float64 `json:"unrealized_pnl"`
+	GrossExposure float64 `json:"gross_exposure"`
+	NetExposure   float64 `json:"net_exposure"`
+}
+
+// PortfolioSummary pnl and exposure of all user positions with breakdown by instrument
+type PortfolioSummary struct {
+	User          string               `json:"user"`
+	OpenPositions int                  `json:"open_positions"`
+	RealizedPNL   float64              `json:"realized_pnl"`
+	UnrealizedPNL float64              `json:"unrealized_pnl"`
+	GrossExposure float64              `json:"gross_exposure"`
+	NetExposure   float64              `json:"net_exposure"`
+	Instruments   []*InstrumentSummary `json:"instruments"`
+}
@@ -1,7 +1,61 @@
 // Package model position model
 package model
 
-import "time"
+import (
+	"errors"
+	"time"
+)
+
+// PositionPending position is opened, its initial margin isn't debited yet
+const PositionPending = "pending"
+
+// PositionOpen position is opened and paid
+const PositionOpen = "open"
+
+// PositionClosing close of position is in progress
+const PositionClosing = "closing"
+
+// PositionClosed position is closed
+const PositionClosed = "closed"
+
+// PositionFailed position is closed because its initial margin couldn't be debited
+const PositionFailed = "failed"
+
+// CloseManual position is closed by user
+const CloseManual = "manual"
+
+// CloseStopLoss position is closed by stop loss
+const CloseStopLoss = "stop_loss"
+
+// CloseTakeProfit position is closed by take profit
+const CloseTakeProfit = "take_profit"
+
+// CloseTrailingStop position is closed by trailing stop
+const CloseTrailingStop = "trailing_stop"
+
+// CloseLiquidation position is liquidated, the cause is stored as liquidation reason
+const CloseLiquidation = "liquidation"
+
+// ClosePaymentFailed position is closed because its initial margin couldn't be debited
+const ClosePaymentFailed = "payment_failed"
+
+// ErrPositionClosing position is already being closed by another request or listener
+var ErrPositionClosing = errors.New("position close is already in progress")
+
+// ErrPositionClosed position is already closed
+var ErrPositionClosed = errors.New("position is already closed")
+
+// ErrPositionTransition position status doesn't allow requested change
+var ErrPositionTransition = errors.New("position status doesn't allow this transition")
+
+// LiquidationMaintenanceMargin position equity dropped below maintenance margin
+const LiquidationMaintenanceMargin = "maintenance_margin"
+
+// LiquidationNegativePNL user portfolio equity with free balance dropped below maintenance margin of portfolio
+const LiquidationNegativePNL = "negative_pnl"
+
+// LiquidationPaymentFailed opening margin of position couldn't be debited
+const LiquidationPaymentFailed = "payment_failed"
 
 // Position model
 type Position struct {
@@ -15,6 +69,49 @@ type Position struct {
 	TakeProfit    float64   `json:"take_profit"`
 	ShortPosition bool      `json:"short_position"`
 	Closed        int64     `json:"closed"`
+	Status        string    `json:"status"`
 	Created       time.Time `json:"created"`
 	Updated       time.Time `json:"updated"`
+
+	TrailingStop       float64 `json:"trailing_stop"`
+	TrailingPercentage bool    `json:"trailing_percentage"`
+	TrailingLevel      float64 `json:"trailing_level"`
+
+	Leverage          float64 `json:"leverage"`
+	Margin            float64 `json:"margin"`
+	MaintenanceMargin float64 `json:"maintenance_margin"`
+	LiquidationReason string  `json:"liquidation_reason"`
+
+	CloseReason      string  `json:"close_reason"`
+	TriggerPrice     float64 `json:"trigger_price"`
+	RealizedPNL      float64 `json:"realized_pnl"`
+	Fees             float64 `json:"fees"`
+	AccruedFinancing float64 `json:"accrued_financing"`
+}
+
+// InitialMargin margin of position, positions opened before margin trading hold full notional
+func (p *Position) InitialMargin() float64 {
+	if p.Margin > 0 {
+		return p.Margin
+	}
+	return p.Amount * p.PurchasePrice
+}
+
+// ErrInvalidCursor cursor of positions page is malformed
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// PositionFilter filter, sort order and page of user positions, zero values don't filter;
+// created range includes its start and excludes its end, closed range is in unix seconds
+type PositionFilter struct {
+	User        string
+	Open        *bool
+	Name        string
+	CreatedFrom time.Time
+	CreatedTo   time.Time
+	ClosedFrom  int64
+	ClosedTo    int64
+	Short       *bool
+	Descending  bool
+	Limit       int
+	Cursor      string
 }
@@ -1,6 +1,11 @@
 // Package model models
 package model
 
+import "errors"
+
+// ErrPricesStale prices stream is broken, so new positions wouldn't be watched by triggers
+var ErrPricesStale = errors.New("prices stream is stale")
+
 // Price info about one position
 type Price struct {
 	Name string
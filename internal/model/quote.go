@@ -0,0 +1,36 @@
+// Package model quote model
+package model
+
+import (
+	"errors"
+	"time"
+)
+
+// ErrQuoteNotFound quote doesn't exist
+var ErrQuoteNotFound = errors.New("quote not found")
+
+// ErrQuoteExpired quote wasn't executed before its expiration
+var ErrQuoteExpired = errors.New("quote expired")
+
+// ErrQuoteUsed quote was already executed
+var ErrQuoteUsed = errors.New("quote was already executed")
+
+// Quote price of instrument firm for user until expiration, it can be executed once
+type Quote struct {
+	ID            string    `json:"id"`
+	User          string    `json:"user"`
+	Name          string    `json:"name"`
+	Amount        float64   `json:"amount"`
+	ShortPosition bool      `json:"short_position"`
+	PurchasePrice float64   `json:"purchase_price"`
+	SellingPrice  float64   `json:"selling_price"`
+	PositionID    string    `json:"position_id"`
+	Expires       time.Time `json:"expires"`
+	Executed      time.Time `json:"executed"`
+	Created       time.Time `json:"created"`
+}
+
+// Price locked price of quote
+func (q *Quote) Price() *Price {
+	return &Price{Name: q.Name, PurchasePrice: q.PurchasePrice, SellingPrice: q.SellingPrice}
+}
@@ -0,0 +1,80 @@
+// Package model risk model
+package model
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ErrRiskLimit position would breach risk limit of user
+var ErrRiskLimit = errors.New("risk limit exceeded")
+
+// ErrInvalidRiskLimits risk limits without user or with negative limit
+var ErrInvalidRiskLimits = errors.New("risk limits must have user and must not be negative")
+
+// RiskLimits limits of active positions of user, limits apply to positions of all instruments if Name is empty
+// and to positions of Name instrument otherwise, zero limit is not enforced
+type RiskLimits struct {
+	User             string    `json:"user"`
+	Name             string    `json:"name"`
+	MaxOpenPositions int       `json:"max_open_positions"`
+	MaxNotional      float64   `json:"max_notional"`
+	MaxExposure      float64   `json:"max_exposure"`
+	MaxShortExposure float64   `json:"max_short_exposure"`
+	Updated          time.Time `json:"updated"`
+}
+
+// Check returns error describing first limit breached by active positions of user after adding notional to position,
+// position is counted as new one if opening is set
+func (l *RiskLimits) Check(positions []*Position, position *Position, notional float64, opening bool) error {
+	if l.Name != "" && l.Name != position.Name {
+		return nil
+	}
+
+	var open int
+	var exposure, shortExposure float64
+	notionals := make(map[string]float64)
+	for _, p := range positions {
+		if p.Status != PositionPending && p.Status != PositionOpen && p.Status != PositionClosing {
+			continue
+		}
+		if l.Name != "" && l.Name != p.Name {
+			continue
+		}
+		open++
+		pNotional := p.Amount * p.PurchasePrice
+		notionals[p.Name] += pNotional
+		exposure += pNotional
+		if p.ShortPosition {
+			shortExposure += pNotional
+		}
+	}
+	if opening {
+		open++
+	}
+	notionals[position.Name] += notional
+	exposure += notional
+	if position.ShortPosition {
+		shortExposure += notional
+	}
+
+	switch {
+	case l.MaxOpenPositions > 0 && open > l.MaxOpenPositions:
+		return fmt.Errorf("%w: %d open positions%s, max %d", ErrRiskLimit, open, l.scope(), l.MaxOpenPositions)
+	case l.MaxNotional > 0 && notionals[position.Name] > l.MaxNotional:
+		return fmt.Errorf("%w: notional %v of %s, max %v", ErrRiskLimit, notionals[position.Name], position.Name, l.MaxNotional)
+	case l.MaxExposure > 0 && exposure > l.MaxExposure:
+		return fmt.Errorf("%w: exposure %v%s, max %v", ErrRiskLimit, exposure, l.scope(), l.MaxExposure)
+	case l.MaxShortExposure > 0 && shortExposure > l.MaxShortExposure:
+		return fmt.Errorf("%w: short exposure %v%s, max %v", ErrRiskLimit, shortExposure, l.scope(), l.MaxShortExposure)
+	}
+	return nil
+}
+
+func (l *RiskLimits) scope() string {
+	if l.Name == "" {
+		return ""
+	}
+	return " in " + l.Name
+}
@@ -0,0 +1,31 @@
+// Package model slippage model
+package model
+
+import "errors"
+
+// ErrSlippageExceeded execution price is worse than expected price by more than allowed slippage
+var ErrSlippageExceeded = errors.New("execution price is worse than expected by more than max slippage")
+
+// Slippage max deviation of execution price from price expected by client to the worse side,
+// in price units or in basis points of expected price
+type Slippage struct {
+	ExpectedPrice float64 `json:"expected_price"`
+	MaxSlippage   float64 `json:"max_slippage"`
+	BPS           bool    `json:"bps"`
+}
+
+// Exceeded price of buy or sell is worse than expected by more than max slippage, nil slippage accepts any price
+func (s *Slippage) Exceeded(price float64, buy bool) bool {
+	if s == nil {
+		return false
+	}
+	tolerance := s.MaxSlippage
+	if s.BPS {
+		tolerance = s.ExpectedPrice * s.MaxSlippage / 10000
+	}
+	worse := price - s.ExpectedPrice
+	if !buy {
+		worse = -worse
+	}
+	return worse > tolerance
+}
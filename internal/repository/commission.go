@@ -0,0 +1,65 @@
+// Package repository commission
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/OVantsevich/Trading-Service/internal/model"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// Commission postgres entity
+type Commission struct {
+	PgxWithinTransactionRunner
+}
+
+// NewCommissionRepository creating new Commission repository
+func NewCommissionRepository(p PgxWithinTransactionRunner) *Commission {
+	return &Commission{PgxWithinTransactionRunner: p}
+}
+
+// GetCommissionSchedule get the most specific schedule for instrument and tier of user:
+// instrument and tier, then instrument, then tier, then default; returns nil if no schedule matches
+func (c *Commission) GetCommissionSchedule(ctx context.Context, userID, name string) (*model.CommissionSchedule, error) {
+	schedule := &model.CommissionSchedule{}
+	row := c.QueryRow(ctx, `select s.id, s."name", s.tier, s.kind, s.value, s.created from commission_schedules s
+				where (s."name" = $2 or s."name" = '')
+				  and (s.tier = coalesce((select tier from user_tiers where "user" = $1), '') or s.tier = '')
+				order by s."name" = '', s.tier = '' limit 1`, userID, name)
+	err := row.Scan(&schedule.ID, &schedule.Name, &schedule.Tier, &schedule.Kind, &schedule.Value, &schedule.Created)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("commission - GetCommissionSchedule - Scan: %w", err)
+	}
+
+	return schedule, nil
+}
+
+// CreateCommissionSchedule create or replace schedule of instrument and tier
+func (c *Commission) CreateCommissionSchedule(ctx context.Context, schedule *model.CommissionSchedule) error {
+	_, err := c.Exec(ctx, `insert into commission_schedules (id, "name", tier, kind, value, created) values ($1, $2, $3, $4, $5, $6)
+				on conflict ("name", tier) do update set kind = excluded.kind, value = excluded.value;`,
+		schedule.ID, schedule.Name, schedule.Tier, schedule.Kind, schedule.Value, schedule.Created)
+	if err != nil {
+		return fmt.Errorf("commission - CreateCommissionSchedule - Exec: %w", err)
+	}
+
+	return nil
+}
+
+// SetUserTier set commission tier of user
+func (c *Commission) SetUserTier(ctx context.Context, userID, tier string) error {
+	_, err := c.Exec(ctx, `insert into user_tiers ("user", tier, updated) values ($1, $2, now())
+				on conflict ("user") do update set tier = excluded.tier, updated = excluded.updated;`,
+		userID, tier)
+	if err != nil {
+		return fmt.Errorf("commission - SetUserTier - Exec: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,52 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/OVantsevich/Trading-Service/internal/model"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCommission_GetCommissionSchedule(t *testing.T) {
+	ctx := context.Background()
+	user := uuid.NewString()
+	name := uuid.NewString()
+	tier := uuid.NewString()
+
+	schedule, err := testCommissionRepository.GetCommissionSchedule(ctx, user, name)
+	require.NoError(t, err)
+	require.Nil(t, schedule)
+
+	schedules := []*model.CommissionSchedule{
+		{Tier: tier, Kind: model.CommissionFlat, Value: 3},
+		{Name: name, Kind: model.CommissionPercentage, Value: 2},
+		{Name: name, Tier: tier, Kind: model.CommissionPercentage, Value: 1},
+	}
+	err = testCommissionRepository.SetUserTier(ctx, user, tier)
+	require.NoError(t, err)
+	for _, s := range schedules {
+		s.ID = uuid.NewString()
+		s.Created = time.Now()
+		err = testCommissionRepository.CreateCommissionSchedule(ctx, s)
+		require.NoError(t, err)
+
+		schedule, err = testCommissionRepository.GetCommissionSchedule(ctx, user, name)
+		require.NoError(t, err)
+		require.Equal(t, s.Value, schedule.Value)
+	}
+
+	schedule, err = testCommissionRepository.GetCommissionSchedule(ctx, uuid.NewString(), name)
+	require.NoError(t, err)
+	require.Equal(t, 2.0, schedule.Value)
+
+	schedules[2].Value = 0.5
+	err = testCommissionRepository.CreateCommissionSchedule(ctx, schedules[2])
+	require.NoError(t, err)
+	schedule, err = testCommissionRepository.GetCommissionSchedule(ctx, user, name)
+	require.NoError(t, err)
+	require.Equal(t, 0.5, schedule.Value)
+}
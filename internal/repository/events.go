@@ -0,0 +1,62 @@
+// Package repository user events
+package repository
+
+import (
+	"sync"
+
+	"github.com/OVantsevich/Trading-Service/internal/model"
+)
+
+// eventsBuffer events kept for subscriber which doesn't keep up, subscriber is dropped when buffer overflows
+const eventsBuffer = 256
+
+// EventsRepository subscribers of position and order events of users
+type EventsRepository struct {
+	mu          sync.Mutex
+	subscribers map[string]map[chan *model.Event]struct{}
+}
+
+// NewEventsRepository constructor
+func NewEventsRepository() *EventsRepository {
+	return &EventsRepository{subscribers: make(map[string]map[chan *model.Event]struct{})}
+}
+
+// Subscribe subscribe for events of user, channel is closed when subscriber falls behind,
+// returned function unsubscribes
+func (e *EventsRepository) Subscribe(userID string) (<-chan *model.Event, func()) {
+	c := make(chan *model.Event, eventsBuffer)
+	e.mu.Lock()
+	if _, ok := e.subscribers[userID]; !ok {
+		e.subscribers[userID] = make(map[chan *model.Event]struct{})
+	}
+	e.subscribers[userID][c] = struct{}{}
+	e.mu.Unlock()
+	return c, func() {
+		e.mu.Lock()
+		defer e.mu.Unlock()
+		e.remove(userID, c)
+	}
+}
+
+// Publish send event to subscribers of its user without blocking
+func (e *EventsRepository) Publish(event *model.Event) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	for c := range e.subscribers[event.User] {
+		select {
+		case c <- event:
+		default:
+			fanOutMetrics.Add("events_dropped", 1)
+			e.remove(event.User, c)
+			close(c)
+		}
+	}
+}
+
+// remove forget subscriber, must be called under lock
+func (e *EventsRepository) remove(userID string, c chan *model.Event) {
+	delete(e.subscribers[userID], c)
+	if len(e.subscribers[userID]) == 0 {
+		delete(e.subscribers, userID)
+	}
+}
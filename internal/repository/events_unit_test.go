@@ -0,0 +1,42 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/OVantsevich/Trading-Service/internal/model"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventsRepository_Publish(t *testing.T) {
+	events := NewEventsRepository()
+	mine, unsubscribe := events.Subscribe("user")
+	other, unsubscribeOther := events.Subscribe("other")
+	defer unsubscribeOther()
+
+	events.Publish(&model.Event{Type: model.EventClosed, User: "user"})
+	event := <-mine
+	require.Equal(t, model.EventClosed, event.Type)
+	require.Len(t, other, 0)
+
+	unsubscribe()
+	events.Publish(&model.Event{Type: model.EventCreated, User: "user"})
+	require.Len(t, mine, 0)
+}
+
+func TestEventsRepository_Overflow(t *testing.T) {
+	events := NewEventsRepository()
+	slow, unsubscribe := events.Subscribe("user")
+
+	for i := 0; i <= eventsBuffer; i++ {
+		events.Publish(&model.Event{Type: model.EventAmountChanged, User: "user"})
+	}
+	for i := 0; i < eventsBuffer; i++ {
+		_, ok := <-slow
+		require.True(t, ok)
+	}
+	_, ok := <-slow
+	require.False(t, ok)
+
+	unsubscribe()
+}
@@ -0,0 +1,64 @@
+// Package repository financing
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/OVantsevich/Trading-Service/internal/model"
+)
+
+// Financing postgres entity
+type Financing struct {
+	PgxWithinTransactionRunner
+}
+
+// NewFinancingRepository creating new Financing repository
+func NewFinancingRepository(p PgxWithinTransactionRunner) *Financing {
+	return &Financing{PgxWithinTransactionRunner: p}
+}
+
+// GetFinancingRates get financing rates by instrument
+func (f *Financing) GetFinancingRates(ctx context.Context) (map[string]*model.FinancingRate, error) {
+	rows, err := f.Query(ctx, `select "name", long_rate, short_rate, updated from financing_rates`)
+	if err != nil {
+		return nil, fmt.Errorf("financing - GetFinancingRates - Query: %w", err)
+	}
+	defer rows.Close()
+
+	rates := make(map[string]*model.FinancingRate)
+	for rows.Next() {
+		rate := &model.FinancingRate{}
+		err = rows.Scan(&rate.Name, &rate.LongRate, &rate.ShortRate, &rate.Updated)
+		if err != nil {
+			return nil, fmt.Errorf("financing - GetFinancingRates - Scan: %w", err)
+		}
+		rates[rate.Name] = rate
+	}
+
+	return rates, nil
+}
+
+// SetFinancingRate create or replace financing rate of instrument
+func (f *Financing) SetFinancingRate(ctx context.Context, rate *model.FinancingRate) error {
+	_, err := f.Exec(ctx, `insert into financing_rates ("name", long_rate, short_rate, updated) values ($1, $2, $3, $4)
+				on conflict ("name") do update set long_rate = excluded.long_rate, short_rate = excluded.short_rate, updated = excluded.updated;`,
+		rate.Name, rate.LongRate, rate.ShortRate, rate.Updated)
+	if err != nil {
+		return fmt.Errorf("financing - SetFinancingRate - Exec: %w", err)
+	}
+
+	return nil
+}
+
+// CreateAccrual record financing of position for rollover, returns false if it was already accrued
+func (f *Financing) CreateAccrual(ctx context.Context, accrual *model.FinancingAccrual) (bool, error) {
+	tag, err := f.Exec(ctx, `insert into financing_accruals (id, position_id, "user", "name", rollover, rate, notional, amount, created)
+				values ($1, $2, $3, $4, $5, $6, $7, $8, $9) on conflict (position_id, rollover) do nothing;`,
+		accrual.ID, accrual.PositionID, accrual.User, accrual.Name, accrual.Rollover, accrual.Rate, accrual.Notional, accrual.Amount, accrual.Created)
+	if err != nil {
+		return false, fmt.Errorf("financing - CreateAccrual - Exec: %w", err)
+	}
+
+	return tag.RowsAffected() == 1, nil
+}
@@ -0,0 +1,68 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/OVantsevich/Trading-Service/internal/model"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFinancing_Rates_Accruals(t *testing.T) {
+	ctx := context.Background()
+	name := uuid.NewString()[:20]
+
+	err := testFinancingRepository.SetFinancingRate(ctx, &model.FinancingRate{Name: name, LongRate: 0.01, ShortRate: 0.02, Updated: time.Now()})
+	require.NoError(t, err)
+	err = testFinancingRepository.SetFinancingRate(ctx, &model.FinancingRate{Name: name, LongRate: 0.01, ShortRate: 0.03, Updated: time.Now()})
+	require.NoError(t, err)
+	rates, err := testFinancingRepository.GetFinancingRates(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 0.03, rates[name].ShortRate)
+
+	position := &model.Position{
+		ID:            uuid.NewString(),
+		User:          uuid.NewString(),
+		Name:          name,
+		Amount:        10,
+		PurchasePrice: 100,
+		ShortPosition: true,
+		Created:       time.Now(),
+		Updated:       time.Now(),
+	}
+	_, err = testPositionRepository.CreatePosition(ctx, position)
+	require.NoError(t, err)
+	err = testPositionRepository.AddFinancing(ctx, position.ID, 0.3, time.Now())
+	require.ErrorIs(t, err, model.ErrPositionTransition)
+	_, err = testPositionRepository.SetStatus(ctx, position.ID, model.PositionOpen, time.Now())
+	require.NoError(t, err)
+
+	accrual := &model.FinancingAccrual{
+		ID:         uuid.NewString(),
+		PositionID: position.ID,
+		User:       position.User,
+		Name:       name,
+		Rollover:   time.Date(2026, 10, 18, 22, 0, 0, 0, time.UTC),
+		Rate:       0.03,
+		Notional:   1000,
+		Amount:     0.3,
+		Created:    time.Now(),
+	}
+	created, err := testFinancingRepository.CreateAccrual(ctx, accrual)
+	require.NoError(t, err)
+	require.True(t, created)
+	accrual.ID = uuid.NewString()
+	created, err = testFinancingRepository.CreateAccrual(ctx, accrual)
+	require.NoError(t, err)
+	require.False(t, created)
+
+	err = testPositionRepository.AddFinancing(ctx, position.ID, accrual.Amount, time.Now())
+	require.NoError(t, err)
+	pos, err := testPositionRepository.GetPositionByID(ctx, position.ID)
+	require.NoError(t, err)
+	require.Equal(t, 0.3, pos.AccruedFinancing)
+	require.Equal(t, -0.3, pos.RealizedPNL)
+}
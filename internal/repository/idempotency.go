@@ -0,0 +1,66 @@
+// Package repository idempotency
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/OVantsevich/Trading-Service/internal/model"
+)
+
+// Idempotency postgres entity
+type Idempotency struct {
+	PgxWithinTransactionRunner
+}
+
+// NewIdempotencyRepository creating new Idempotency repository
+func NewIdempotencyRepository(p PgxWithinTransactionRunner) *Idempotency {
+	return &Idempotency{PgxWithinTransactionRunner: p}
+}
+
+// CreateKey reserve idempotency key, key of request without response created before staleBefore is taken over,
+// returns false if key already exists
+func (i *Idempotency) CreateKey(ctx context.Context, key *model.IdempotencyKey, staleBefore time.Time) (bool, error) {
+	tag, err := i.Exec(ctx, `insert into idempotency_keys (key, method, request_hash, created) values ($1, $2, $3, $4)
+				on conflict (key) do update set method = excluded.method, request_hash = excluded.request_hash, created = excluded.created
+				where idempotency_keys.response is null and idempotency_keys.created < $5;`,
+		key.Key, key.Method, key.RequestHash, key.Created, staleBefore)
+	if err != nil {
+		return false, fmt.Errorf("idempotency - CreateKey - Exec: %w", err)
+	}
+
+	return tag.RowsAffected() == 1, nil
+}
+
+// GetKey get idempotency key
+func (i *Idempotency) GetKey(ctx context.Context, key string) (*model.IdempotencyKey, error) {
+	res := &model.IdempotencyKey{}
+	row := i.QueryRow(ctx, `select key, method, request_hash, response, created from idempotency_keys where key = $1`, key)
+	err := row.Scan(&res.Key, &res.Method, &res.RequestHash, &res.Response, &res.Created)
+	if err != nil {
+		return nil, fmt.Errorf("idempotency - GetKey - Scan: %w", err)
+	}
+
+	return res, nil
+}
+
+// SaveResponse store response of completed request
+func (i *Idempotency) SaveResponse(ctx context.Context, key string, response []byte) error {
+	_, err := i.Exec(ctx, `update idempotency_keys set response=$1 where key=$2;`, response, key)
+	if err != nil {
+		return fmt.Errorf("idempotency - SaveResponse - Exec: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteKey release key of failed request
+func (i *Idempotency) DeleteKey(ctx context.Context, key string) error {
+	_, err := i.Exec(ctx, `delete from idempotency_keys where key=$1 and response is null;`, key)
+	if err != nil {
+		return fmt.Errorf("idempotency - DeleteKey - Exec: %w", err)
+	}
+
+	return nil
+}
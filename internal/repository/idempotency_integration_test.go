@@ -0,0 +1,76 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/OVantsevich/Trading-Service/internal/model"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIdempotency_Create_Save_Delete(t *testing.T) {
+	ctx := context.Background()
+	key := &model.IdempotencyKey{
+		Key:         uuid.NewString(),
+		Method:      "OpenPosition",
+		RequestHash: []byte("hash"),
+		Created:     time.Now(),
+	}
+
+	created, err := testIdempotencyRepository.CreateKey(ctx, key, key.Created.Add(-time.Minute))
+	require.NoError(t, err)
+	require.True(t, created)
+	created, err = testIdempotencyRepository.CreateKey(ctx, key, key.Created.Add(-time.Minute))
+	require.NoError(t, err)
+	require.False(t, created)
+
+	stored, err := testIdempotencyRepository.GetKey(ctx, key.Key)
+	require.NoError(t, err)
+	require.Nil(t, stored.Response)
+	require.Equal(t, key.RequestHash, stored.RequestHash)
+
+	err = testIdempotencyRepository.DeleteKey(ctx, key.Key)
+	require.NoError(t, err)
+	_, err = testIdempotencyRepository.GetKey(ctx, key.Key)
+	require.Error(t, err)
+
+	created, err = testIdempotencyRepository.CreateKey(ctx, key, key.Created.Add(-time.Minute))
+	require.NoError(t, err)
+	require.True(t, created)
+	err = testIdempotencyRepository.SaveResponse(ctx, key.Key, []byte("response"))
+	require.NoError(t, err)
+	err = testIdempotencyRepository.DeleteKey(ctx, key.Key)
+	require.NoError(t, err)
+
+	stored, err = testIdempotencyRepository.GetKey(ctx, key.Key)
+	require.NoError(t, err)
+	require.Equal(t, []byte("response"), stored.Response)
+}
+
+func TestIdempotency_CreateKey_Stale(t *testing.T) {
+	ctx := context.Background()
+	key := &model.IdempotencyKey{
+		Key:         uuid.NewString(),
+		Method:      "OpenPosition",
+		RequestHash: []byte("hash"),
+		Created:     time.Now().Add(-time.Hour),
+	}
+
+	created, err := testIdempotencyRepository.CreateKey(ctx, key, key.Created.Add(-time.Minute))
+	require.NoError(t, err)
+	require.True(t, created)
+
+	key.Created = time.Now()
+	created, err = testIdempotencyRepository.CreateKey(ctx, key, key.Created.Add(-time.Minute))
+	require.NoError(t, err)
+	require.True(t, created)
+
+	err = testIdempotencyRepository.SaveResponse(ctx, key.Key, []byte("response"))
+	require.NoError(t, err)
+	created, err = testIdempotencyRepository.CreateKey(ctx, key, time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	require.False(t, created)
+}
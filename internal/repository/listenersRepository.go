@@ -2,164 +2,444 @@
 package repository
 
 import (
+	"container/heap"
 	"context"
 	"fmt"
-	"github.com/OVantsevich/Trading-Service/internal/model"
+	"math"
 	"sync"
+
+	"github.com/OVantsevich/Trading-Service/internal/model"
 )
 
-// ListenersRepository listeners repository
+// percent divider for percentage trailing stop
+const percent = 100.0
+
+// trigger threshold of position, index is position in the book heap or -1 if trigger isn't armed
+type trigger struct {
+	position *model.Position
+	level    float64
+	index    int
+}
+
+// triggerHeap triggers ordered so that the top is crossed first
+type triggerHeap struct {
+	triggers []*trigger
+	before   func(a, b float64) bool
+	crossed  func(price, level float64) bool
+}
+
+func (h *triggerHeap) Len() int { return len(h.triggers) }
+
+func (h *triggerHeap) Less(i, j int) bool { return h.before(h.triggers[i].level, h.triggers[j].level) }
+
+func (h *triggerHeap) Swap(i, j int) {
+	h.triggers[i], h.triggers[j] = h.triggers[j], h.triggers[i]
+	h.triggers[i].index = i
+	h.triggers[j].index = j
+}
+
+func (h *triggerHeap) Push(x any) {
+	t := x.(*trigger)
+	t.index = len(h.triggers)
+	h.triggers = append(h.triggers, t)
+}
+
+func (h *triggerHeap) Pop() any {
+	n := len(h.triggers) - 1
+	t := h.triggers[n]
+	h.triggers[n] = nil
+	h.triggers = h.triggers[:n]
+	t.index = -1
+	return t
+}
+
+// pop remove all triggers crossed by price
+func (h *triggerHeap) pop(price float64) []*trigger {
+	var result []*trigger
+	for h.Len() > 0 && h.crossed(price, h.triggers[0].level) {
+		result = append(result, heap.Pop(h).(*trigger))
+	}
+	return result
+}
+
+// remove disarm trigger
+func (h *triggerHeap) remove(t *trigger) {
+	if t.index >= 0 {
+		heap.Remove(h, t.index)
+	}
+}
+
+func ascending(a, b float64) bool { return a < b }
+
+func descending(a, b float64) bool { return a > b }
+
+// trailing trailing stop of position, stop is crossed by price like stop loss,
+// peak is the best price the level was ratcheted at, only price beyond it can move the level
+type trailing struct {
+	stop *trigger
+	peak *trigger
+}
+
+// side triggers of long or short positions of one instrument
+type side struct {
+	takeProfit   *triggerHeap
+	stopLoss     *triggerHeap
+	trailingStop *triggerHeap
+	trailingPeak *triggerHeap
+}
+
+// book ordered triggers of one instrument
+type book struct {
+	long  side
+	short side
+}
+
+func newBook() *book {
+	return &book{
+		long: side{
+			takeProfit:   &triggerHeap{before: ascending, crossed: func(price, level float64) bool { return price >= level }},
+			stopLoss:     &triggerHeap{before: descending, crossed: func(price, level float64) bool { return price <= level }},
+			trailingStop: &triggerHeap{before: descending, crossed: func(price, level float64) bool { return price <= level }},
+			trailingPeak: &triggerHeap{before: ascending, crossed: func(price, level float64) bool { return price > level }},
+		},
+		short: side{
+			takeProfit:   &triggerHeap{before: descending, crossed: func(price, level float64) bool { return price < level }},
+			stopLoss:     &triggerHeap{before: ascending, crossed: func(price, level float64) bool { return price > level }},
+			trailingStop: &triggerHeap{before: ascending, crossed: func(price, level float64) bool { return price > level }},
+			trailingPeak: &triggerHeap{before: descending, crossed: func(price, level float64) bool { return price < level }},
+		},
+	}
+}
+
+func (s *side) pushTrailing(tr *trailing) {
+	heap.Push(s.trailingStop, tr.stop)
+	heap.Push(s.trailingPeak, tr.peak)
+}
+
+func (s *side) removeTrailing(tr *trailing) {
+	s.trailingStop.remove(tr.stop)
+	s.trailingPeak.remove(tr.peak)
+}
+
+func (b *book) side(position *model.Position) *side {
+	if position.ShortPosition {
+		return &b.short
+	}
+	return &b.long
+}
+
+// ListenersRepository books of SL, TP and trailing stop triggers,
+// legs of one position are one-cancels-other: the first crossed disarms others
 type ListenersRepository struct {
-	mu              sync.RWMutex
-	closedPositions chan *model.Position
-	listenersTP     map[string]map[string]chan *model.Price
-	listenersSL     map[string]map[string]chan *model.Price
+	mu     sync.Mutex
+	books  map[string]*book
+	fired  map[string]bool
+	closed []*model.Position
+	levels map[string]*model.Position
+
+	closedSignal chan struct{}
+	levelSignal  chan struct{}
+
+	listenersTP map[string]map[string]*trigger
+	listenersSL map[string]map[string]*trigger
+	listenersTS map[string]map[string]*trailing
 }
 
 // NewListenersRepository constructor
 func NewListenersRepository() *ListenersRepository {
-	cpChan := make(chan *model.Position)
-	listenersTP := make(map[string]map[string]chan *model.Price)
-	listenersSL := make(map[string]map[string]chan *model.Price)
 	return &ListenersRepository{
-		closedPositions: cpChan,
-		listenersTP:     listenersTP,
-		listenersSL:     listenersSL,
+		books:        make(map[string]*book),
+		fired:        make(map[string]bool),
+		levels:       make(map[string]*model.Position),
+		closedSignal: make(chan struct{}, 1),
+		levelSignal:  make(chan struct{}, 1),
+		listenersTP:  make(map[string]map[string]*trigger),
+		listenersSL:  make(map[string]map[string]*trigger),
+		listenersTS:  make(map[string]map[string]*trailing),
 	}
 }
 
-// CreateListenerTP create take profit listener
-//
-//nolint:dupl //just because
-func (l *ListenersRepository) CreateListenerTP(ctx context.Context, position *model.Position) error {
+// CreateListenerTP create take profit listener, replaces existing listener of the position
+func (l *ListenersRepository) CreateListenerTP(_ context.Context, position *model.Position) error {
 	l.mu.Lock()
-	lis, ok := l.listenersTP[position.Name]
-	if !ok {
-		l.listenersTP[position.Name] = make(map[string]chan *model.Price)
+	defer l.mu.Unlock()
+	b := l.book(position.Name)
+	if t, ok := l.listenersTP[position.Name][position.ID]; ok {
+		b.side(t.position).takeProfit.remove(t)
+	}
+	t := l.register(l.listenersTP, position, position.TakeProfit)
+	if !l.fired[position.ID] {
+		heap.Push(b.side(position).takeProfit, t)
+	}
+	return nil
+}
+
+// CreateListenerSL create stop loss listener, replaces existing listener of the position
+func (l *ListenersRepository) CreateListenerSL(_ context.Context, position *model.Position) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	b := l.book(position.Name)
+	if t, ok := l.listenersSL[position.Name][position.ID]; ok {
+		b.side(t.position).stopLoss.remove(t)
+	}
+	t := l.register(l.listenersSL, position, position.StopLoss)
+	if !l.fired[position.ID] {
+		heap.Push(b.side(position).stopLoss, t)
+	}
+	return nil
+}
+
+// CreateListenerTS create trailing stop listener, replaces existing listener of the position
+func (l *ListenersRepository) CreateListenerTS(_ context.Context, position *model.Position) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	b := l.book(position.Name)
+	if tr, ok := l.listenersTS[position.Name][position.ID]; ok {
+		b.side(tr.stop.position).removeTrailing(tr)
+	}
+	if _, ok := l.listenersTS[position.Name]; !ok {
+		l.listenersTS[position.Name] = make(map[string]*trailing)
+	}
+	pos := *position
+	peak := math.Inf(-1)
+	if pos.ShortPosition {
+		peak = math.Inf(1)
+	}
+	tr := &trailing{
+		stop: &trigger{position: &pos, level: pos.TrailingLevel, index: -1},
+		peak: &trigger{position: &pos, level: peak, index: -1},
+	}
+	l.listenersTS[position.Name][position.ID] = tr
+	if !l.fired[position.ID] {
+		b.side(position).pushTrailing(tr)
 	}
-	_, ok = lis[position.ID]
-	if ok {
-		l.mu.Unlock()
-		return fmt.Errorf("listenersRepository - CreateListenerSL: listener with this name and positionID alredy exist")
-	}
-	channel := make(chan *model.Price, 1)
-	go listener(ctx, channel, l.closedPositions, &(*position), func(price *model.Price, p *model.Position) bool {
-		return price.SellingPrice >= p.TakeProfit != p.ShortPosition
-	})
-	l.listenersTP[position.Name][position.ID] = channel
-	l.mu.Unlock()
 	return nil
 }
 
-// CreateListenerSL create stop loss listener
-//
-//nolint:dupl //just because
-func (l *ListenersRepository) CreateListenerSL(ctx context.Context, position *model.Position) error {
+// RemoveListenerTS remove trailing stop listener
+func (l *ListenersRepository) RemoveListenerTS(position *model.Position) error {
 	l.mu.Lock()
-	lis, ok := l.listenersSL[position.Name]
+	defer l.mu.Unlock()
+	tr, ok := l.listenersTS[position.Name][position.ID]
 	if !ok {
-		l.listenersSL[position.Name] = make(map[string]chan *model.Price)
+		return fmt.Errorf("listenersRepository - RemoveListenerTS: listener with this name and positionID does't exist")
 	}
-	_, ok = lis[position.ID]
-	if ok {
-		l.mu.Unlock()
-		return fmt.Errorf("listenersRepository - CreateListenerSL: listener with this name and positionID alredy exist")
-	}
-	channel := make(chan *model.Price, 1)
-	go listener(ctx, channel, l.closedPositions, &(*position), func(price *model.Price, p *model.Position) bool {
-		return price.SellingPrice <= p.StopLoss != p.ShortPosition
-	})
-	l.listenersSL[position.Name][position.ID] = channel
-	l.mu.Unlock()
+	delete(l.listenersTS[position.Name], position.ID)
+	l.book(position.Name).side(tr.stop.position).removeTrailing(tr)
+	l.release(position)
 	return nil
 }
 
 // RemoveListenerTP remove take profit listener
 func (l *ListenersRepository) RemoveListenerTP(position *model.Position) error {
 	l.mu.Lock()
-	lis := l.listenersTP[position.Name]
-	channel, ok := lis[position.ID]
+	defer l.mu.Unlock()
+	t, ok := l.listenersTP[position.Name][position.ID]
 	if !ok {
-		l.mu.Unlock()
 		return fmt.Errorf("listenersRepository - RemoveListenerTP: listener with this name and positionID does't exist")
 	}
-	close(channel)
 	delete(l.listenersTP[position.Name], position.ID)
-	l.mu.Unlock()
+	l.book(position.Name).side(t.position).takeProfit.remove(t)
+	l.release(position)
 	return nil
 }
 
 // RemoveListenerSL remove stop loss listener
 func (l *ListenersRepository) RemoveListenerSL(position *model.Position) error {
 	l.mu.Lock()
-	lis := l.listenersSL[position.Name]
-	channel, ok := lis[position.ID]
+	defer l.mu.Unlock()
+	t, ok := l.listenersSL[position.Name][position.ID]
 	if !ok {
-		l.mu.Unlock()
 		return fmt.Errorf("listenersRepository - RemoveListenerSL: listener with this name and positionID does't exist")
 	}
-	close(channel)
 	delete(l.listenersSL[position.Name], position.ID)
-	l.mu.Unlock()
+	l.book(position.Name).side(t.position).stopLoss.remove(t)
+	l.release(position)
 	return nil
 }
 
-// SendPrices sending prices for all listeners
+// register store trigger of position copy, must be called under lock
+func (l *ListenersRepository) register(listeners map[string]map[string]*trigger, position *model.Position, level float64) *trigger {
+	if _, ok := listeners[position.Name]; !ok {
+		listeners[position.Name] = make(map[string]*trigger)
+	}
+	pos := *position
+	t := &trigger{position: &pos, level: level, index: -1}
+	listeners[position.Name][position.ID] = t
+	return t
+}
+
+// book get or create book of instrument, must be called under lock
+func (l *ListenersRepository) book(name string) *book {
+	b, ok := l.books[name]
+	if !ok {
+		b = newBook()
+		l.books[name] = b
+	}
+	return b
+}
+
+// release forget fired position when it has no legs left, must be called under lock
+func (l *ListenersRepository) release(position *model.Position) {
+	_, sl := l.listenersSL[position.Name][position.ID]
+	_, tp := l.listenersTP[position.Name][position.ID]
+	_, ts := l.listenersTS[position.Name][position.ID]
+	if !sl && !tp && !ts {
+		delete(l.fired, position.ID)
+	}
+}
+
+// fire queue position to close by the crossed leg and disarm its other legs, must be called under lock
+func (l *ListenersRepository) fire(b *book, position *model.Position, price float64, reason string) {
+	if l.fired[position.ID] {
+		return
+	}
+	l.fired[position.ID] = true
+	s := b.side(position)
+	if t, ok := l.listenersTP[position.Name][position.ID]; ok {
+		s.takeProfit.remove(t)
+	}
+	if t, ok := l.listenersSL[position.Name][position.ID]; ok {
+		s.stopLoss.remove(t)
+	}
+	if tr, ok := l.listenersTS[position.Name][position.ID]; ok {
+		s.removeTrailing(tr)
+	}
+
+	pos := *position
+	pos.SellingPrice = price
+	pos.TriggerPrice = price
+	pos.CloseReason = reason
+	l.closed = append(l.closed, &pos)
+	signal(l.closedSignal)
+}
+
+// RearmListeners arm again legs of position which close failed, so they can fire on the next crossing price
+func (l *ListenersRepository) RearmListeners(position *model.Position) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if !l.fired[position.ID] {
+		return
+	}
+	delete(l.fired, position.ID)
+	s := l.book(position.Name).side(position)
+	if t, ok := l.listenersTP[position.Name][position.ID]; ok {
+		heap.Push(s.takeProfit, t)
+	}
+	if t, ok := l.listenersSL[position.Name][position.ID]; ok {
+		heap.Push(s.stopLoss, t)
+	}
+	if tr, ok := l.listenersTS[position.Name][position.ID]; ok {
+		s.pushTrailing(tr)
+	}
+}
+
+// SendPrices fire triggers crossed by prices, trailing stops are ratcheted only by price beyond their peak
 func (l *ListenersRepository) SendPrices(prices []*model.Price) {
-	l.mu.RLock()
+	l.mu.Lock()
+	defer l.mu.Unlock()
 	for _, p := range prices {
-		for _, lis := range l.listenersSL[p.Name] {
-			lis <- &(*p)
+		b, ok := l.books[p.Name]
+		if !ok {
+			continue
 		}
-		for _, lis := range l.listenersTP[p.Name] {
-			lis <- &(*p)
+		for _, s := range []*side{&b.long, &b.short} {
+			for _, t := range s.takeProfit.pop(p.SellingPrice) {
+				l.fire(b, t.position, p.SellingPrice, model.CloseTakeProfit)
+			}
+			for _, t := range s.stopLoss.pop(p.SellingPrice) {
+				l.fire(b, t.position, p.SellingPrice, model.CloseStopLoss)
+			}
+			for _, peak := range s.trailingPeak.pop(p.SellingPrice) {
+				tr := l.listenersTS[p.Name][peak.position.ID]
+				if ratchet(p, peak.position) {
+					tr.stop.level = peak.position.TrailingLevel
+					heap.Fix(s.trailingStop, tr.stop.index)
+					level := *peak.position
+					l.levels[level.ID] = &level
+					signal(l.levelSignal)
+				}
+				peak.level = p.SellingPrice
+				heap.Push(s.trailingPeak, peak)
+			}
+			for _, t := range s.trailingStop.pop(p.SellingPrice) {
+				l.fire(b, t.position, p.SellingPrice, model.CloseTrailingStop)
+			}
 		}
 	}
-	l.mu.RUnlock()
 }
 
 // ClosePosition sync await for closed position from listeners
 func (l *ListenersRepository) ClosePosition(ctx context.Context) (*model.Position, error) {
-	select {
-	case <-ctx.Done():
-		return nil, fmt.Errorf("listenersRepository - ClosePosition: context canceld")
-	case position := <-l.closedPositions:
-		return position, nil
-	}
-}
-
-func listener(ctx context.Context, cin chan *model.Price, cout chan *model.Position, position *model.Position,
-	comp func(*model.Price, *model.Position) bool,
-) {
-	var price *model.Price
-	var ok bool
 	for {
+		l.mu.Lock()
+		if len(l.closed) > 0 {
+			position := l.closed[0]
+			l.closed[0] = nil
+			l.closed = l.closed[1:]
+			l.mu.Unlock()
+			return position, nil
+		}
+		l.mu.Unlock()
 		select {
 		case <-ctx.Done():
-			return
-		case price, ok = <-cin:
-			if !ok {
-				return
-			}
-			if comp(price, position) {
-				position.SellingPrice = price.SellingPrice
-				cout <- position
-				skip(ctx, cin)
-				return
-			}
+			return nil, fmt.Errorf("listenersRepository - ClosePosition: context canceld")
+		case <-l.closedSignal:
 		}
 	}
 }
 
-func skip(ctx context.Context, cin chan *model.Price) {
+// TrailingLevel sync await for ratcheted trailing stop level, only the latest level of position is kept
+func (l *ListenersRepository) TrailingLevel(ctx context.Context) (*model.Position, error) {
 	for {
+		l.mu.Lock()
+		for id, position := range l.levels {
+			delete(l.levels, id)
+			if len(l.levels) > 0 {
+				signal(l.levelSignal)
+			}
+			l.mu.Unlock()
+			return position, nil
+		}
+		l.mu.Unlock()
 		select {
 		case <-ctx.Done():
-			return
-		case _, ok := <-cin:
-			if !ok {
-				return
-			}
+			return nil, fmt.Errorf("listenersRepository - TrailingLevel: context canceld")
+		case <-l.levelSignal:
+		}
+	}
+}
+
+// signal wake up waiting reader without blocking
+func signal(c chan struct{}) {
+	select {
+	case c <- struct{}{}:
+	default:
+	}
+}
+
+// trailingDistance distance between selling price and trailing stop level
+func trailingDistance(price float64, p *model.Position) float64 {
+	if p.TrailingPercentage {
+		return price * p.TrailingStop / percent
+	}
+	return p.TrailingStop
+}
+
+// ratchet moves trailing stop level after the best selling price, returns true if level was moved
+func ratchet(price *model.Price, p *model.Position) bool {
+	if p.ShortPosition {
+		level := price.SellingPrice + trailingDistance(price.SellingPrice, p)
+		if p.TrailingLevel == 0 || level < p.TrailingLevel {
+			p.TrailingLevel = level
+			return true
 		}
+		return false
+	}
+	level := price.SellingPrice - trailingDistance(price.SellingPrice, p)
+	if level > p.TrailingLevel {
+		p.TrailingLevel = level
+		return true
 	}
+	return false
 }
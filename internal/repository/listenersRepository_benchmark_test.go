@@ -0,0 +1,94 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/OVantsevich/Trading-Service/internal/model"
+)
+
+// benchmarkTriggers number of stops per instrument
+const benchmarkTriggers = 10000
+
+// goroutineListeners previous design: goroutine with channel for every threshold, every price is sent to every channel
+type goroutineListeners struct {
+	mu        sync.RWMutex
+	closed    chan *model.Position
+	listeners map[string]map[string]chan *model.Price
+}
+
+func (l *goroutineListeners) create(ctx context.Context, position *model.Position) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if _, ok := l.listeners[position.Name]; !ok {
+		l.listeners[position.Name] = make(map[string]chan *model.Price)
+	}
+	channel := make(chan *model.Price, 1)
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case price := <-channel:
+				if price.SellingPrice <= position.StopLoss {
+					l.closed <- position
+					return
+				}
+			}
+		}
+	}()
+	l.listeners[position.Name][position.ID] = channel
+}
+
+func (l *goroutineListeners) send(prices []*model.Price) {
+	l.mu.RLock()
+	for _, p := range prices {
+		for _, lis := range l.listeners[p.Name] {
+			lis <- p
+		}
+	}
+	l.mu.RUnlock()
+}
+
+func benchmarkPositions() []*model.Position {
+	positions := make([]*model.Position, benchmarkTriggers)
+	for i := range positions {
+		positions[i] = &model.Position{
+			ID:       fmt.Sprint(i),
+			Name:     "benchmark",
+			StopLoss: float64(i%100 + 1),
+		}
+	}
+	return positions
+}
+
+func BenchmarkListenersRepository_SendPrices_Book(b *testing.B) {
+	ctx := context.Background()
+	l := NewListenersRepository()
+	for _, p := range benchmarkPositions() {
+		_ = l.CreateListenerSL(ctx, p)
+	}
+	prices := []*model.Price{{Name: "benchmark", SellingPrice: 1000}}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		l.SendPrices(prices)
+	}
+}
+
+func BenchmarkListenersRepository_SendPrices_Goroutines(b *testing.B) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	l := &goroutineListeners{closed: make(chan *model.Position), listeners: make(map[string]map[string]chan *model.Price)}
+	for _, p := range benchmarkPositions() {
+		l.create(ctx, p)
+	}
+	prices := []*model.Price{{Name: "benchmark", SellingPrice: 1000}}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		l.send(prices)
+	}
+}
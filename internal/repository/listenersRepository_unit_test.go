@@ -5,6 +5,7 @@ import (
 	"github.com/OVantsevich/Trading-Service/internal/model"
 	"github.com/stretchr/testify/require"
 	"testing"
+	"time"
 )
 
 func TestListenersRepository_CreateListener(t *testing.T) {
@@ -19,9 +20,9 @@ func TestListenersRepository_CreateListener(t *testing.T) {
 	err = testListenersRepository.CreateListenerTP(ctx, position)
 	require.NoError(t, err)
 	err = testListenersRepository.CreateListenerSL(ctx, position)
-	require.Error(t, err)
+	require.NoError(t, err)
 	err = testListenersRepository.CreateListenerTP(ctx, position)
-	require.Error(t, err)
+	require.NoError(t, err)
 	err = testListenersRepository.RemoveListenerSL(position)
 	require.NoError(t, err)
 	err = testListenersRepository.RemoveListenerTP(position)
@@ -119,3 +120,215 @@ func TestListenersRepository_SendPrices(t *testing.T) {
 	err = testListenersRepository.CreateListenerSL(ctx, position)
 	require.NoError(t, err)
 }
+
+func TestListenersRepository_TrailingStop(t *testing.T) {
+	ctx := context.Background()
+	position := &model.Position{
+		ID:           "testTrailingID",
+		Name:         "testTrailingName",
+		TrailingStop: 10,
+	}
+
+	err := testListenersRepository.CreateListenerTS(ctx, position)
+	require.NoError(t, err)
+
+	testListenersRepository.SendPrices([]*model.Price{{Name: position.Name, SellingPrice: 100}})
+	level, err := testListenersRepository.TrailingLevel(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 90.0, level.TrailingLevel)
+
+	testListenersRepository.SendPrices([]*model.Price{{Name: position.Name, SellingPrice: 120}})
+	level, err = testListenersRepository.TrailingLevel(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 110.0, level.TrailingLevel)
+
+	testListenersRepository.SendPrices([]*model.Price{{Name: position.Name, SellingPrice: 109}})
+	pos, err := testListenersRepository.ClosePosition(ctx)
+	require.NoError(t, err)
+	require.Equal(t, position.ID, pos.ID)
+	require.Equal(t, 109.0, pos.SellingPrice)
+	require.Equal(t, model.CloseTrailingStop, pos.CloseReason)
+
+	err = testListenersRepository.RemoveListenerTS(position)
+	require.NoError(t, err)
+	err = testListenersRepository.RemoveListenerTS(position)
+	require.Error(t, err)
+
+	position.ShortPosition = true
+	position.TrailingPercentage = true
+	err = testListenersRepository.CreateListenerTS(ctx, position)
+	require.NoError(t, err)
+
+	testListenersRepository.SendPrices([]*model.Price{{Name: position.Name, SellingPrice: 100}})
+	level, err = testListenersRepository.TrailingLevel(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 110.0, level.TrailingLevel)
+
+	testListenersRepository.SendPrices([]*model.Price{{Name: position.Name, SellingPrice: 111}})
+	pos, err = testListenersRepository.ClosePosition(ctx)
+	require.NoError(t, err)
+	require.Equal(t, position.ID, pos.ID)
+
+	err = testListenersRepository.RemoveListenerTS(position)
+	require.NoError(t, err)
+}
+
+func TestListenersRepository_Bracket(t *testing.T) {
+	ctx := context.Background()
+	position := &model.Position{
+		ID:         "testBracketID",
+		Name:       "testBracketName",
+		StopLoss:   100,
+		TakeProfit: 100,
+	}
+
+	err := testListenersRepository.CreateListenerSL(ctx, position)
+	require.NoError(t, err)
+	err = testListenersRepository.CreateListenerTP(ctx, position)
+	require.NoError(t, err)
+
+	testListenersRepository.SendPrices([]*model.Price{{Name: position.Name, SellingPrice: 100}})
+	pos, err := testListenersRepository.ClosePosition(ctx)
+	require.NoError(t, err)
+	require.Equal(t, position.ID, pos.ID)
+
+	timeout, cancel := context.WithTimeout(ctx, time.Millisecond*100)
+	_, err = testListenersRepository.ClosePosition(timeout)
+	cancel()
+	require.Error(t, err)
+
+	err = testListenersRepository.RemoveListenerSL(position)
+	require.NoError(t, err)
+	err = testListenersRepository.RemoveListenerTP(position)
+	require.NoError(t, err)
+}
+
+func TestListenersRepository_ReplaceListener(t *testing.T) {
+	ctx := context.Background()
+	position := &model.Position{
+		ID:       "testReplaceID",
+		Name:     "testReplaceName",
+		StopLoss: 90,
+	}
+
+	err := testListenersRepository.CreateListenerSL(ctx, position)
+	require.NoError(t, err)
+	replaced := *position
+	replaced.StopLoss = 80
+	err = testListenersRepository.CreateListenerSL(ctx, &replaced)
+	require.NoError(t, err)
+
+	testListenersRepository.SendPrices([]*model.Price{{Name: position.Name, SellingPrice: 85}})
+	waitCtx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+	defer cancel()
+	_, err = testListenersRepository.ClosePosition(waitCtx)
+	require.Error(t, err)
+
+	testListenersRepository.SendPrices([]*model.Price{{Name: position.Name, SellingPrice: 79}})
+	pos, err := testListenersRepository.ClosePosition(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 80.0, pos.StopLoss)
+
+	err = testListenersRepository.RemoveListenerSL(pos)
+	require.NoError(t, err)
+}
+
+func TestListenersRepository_RearmListeners(t *testing.T) {
+	ctx := context.Background()
+	position := &model.Position{
+		ID:           "testRearmID",
+		Name:         "testRearmName",
+		StopLoss:     90,
+		TakeProfit:   110,
+		TrailingStop: 5,
+	}
+
+	err := testListenersRepository.CreateListenerSL(ctx, position)
+	require.NoError(t, err)
+	err = testListenersRepository.CreateListenerTP(ctx, position)
+	require.NoError(t, err)
+	err = testListenersRepository.CreateListenerTS(ctx, position)
+	require.NoError(t, err)
+
+	testListenersRepository.SendPrices([]*model.Price{{Name: position.Name, SellingPrice: 89}})
+	pos, err := testListenersRepository.ClosePosition(ctx)
+	require.NoError(t, err)
+	require.Equal(t, model.CloseStopLoss, pos.CloseReason)
+
+	testListenersRepository.RearmListeners(pos)
+	testListenersRepository.SendPrices([]*model.Price{{Name: position.Name, SellingPrice: 111}})
+	pos, err = testListenersRepository.ClosePosition(ctx)
+	require.NoError(t, err)
+	require.Equal(t, model.CloseTakeProfit, pos.CloseReason)
+
+	testListenersRepository.RearmListeners(pos)
+	err = testListenersRepository.RemoveListenerTP(pos)
+	require.NoError(t, err)
+	testListenersRepository.SendPrices([]*model.Price{{Name: position.Name, SellingPrice: 120}})
+	level, err := testListenersRepository.TrailingLevel(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 115.0, level.TrailingLevel)
+	testListenersRepository.SendPrices([]*model.Price{{Name: position.Name, SellingPrice: 114}})
+	pos, err = testListenersRepository.ClosePosition(ctx)
+	require.NoError(t, err)
+	require.Equal(t, model.CloseTrailingStop, pos.CloseReason)
+
+	err = testListenersRepository.RemoveListenerSL(pos)
+	require.NoError(t, err)
+	err = testListenersRepository.RemoveListenerTS(pos)
+	require.NoError(t, err)
+}
+
+func TestListenersRepository_Book(t *testing.T) {
+	ctx := context.Background()
+	name := "testBookName"
+	positions := []*model.Position{
+		{ID: "longSL", Name: name, StopLoss: 90},
+		{ID: "longTP", Name: name, TakeProfit: 110},
+		{ID: "shortSL", Name: name, StopLoss: 110, ShortPosition: true},
+		{ID: "shortTP", Name: name, TakeProfit: 90, ShortPosition: true},
+	}
+	for _, p := range positions {
+		if p.StopLoss > 0 {
+			require.NoError(t, testListenersRepository.CreateListenerSL(ctx, p))
+		} else {
+			require.NoError(t, testListenersRepository.CreateListenerTP(ctx, p))
+		}
+	}
+
+	testListenersRepository.SendPrices([]*model.Price{{Name: name, SellingPrice: 100}})
+	testListenersRepository.SendPrices([]*model.Price{{Name: name, SellingPrice: 89}})
+	fired := make(map[string]float64)
+	reasons := make(map[string]string)
+	for i := 0; i < 2; i++ {
+		pos, err := testListenersRepository.ClosePosition(ctx)
+		require.NoError(t, err)
+		fired[pos.ID] = pos.TriggerPrice
+		reasons[pos.ID] = pos.CloseReason
+	}
+	require.Equal(t, map[string]float64{"longSL": 89, "shortTP": 89}, fired)
+
+	testListenersRepository.SendPrices([]*model.Price{{Name: name, SellingPrice: 111}})
+	for i := 0; i < 2; i++ {
+		pos, err := testListenersRepository.ClosePosition(ctx)
+		require.NoError(t, err)
+		fired[pos.ID] = pos.TriggerPrice
+		reasons[pos.ID] = pos.CloseReason
+	}
+	require.Equal(t, 111.0, fired["longTP"])
+	require.Equal(t, 111.0, fired["shortSL"])
+	require.Equal(t, map[string]string{
+		"longSL":  model.CloseStopLoss,
+		"longTP":  model.CloseTakeProfit,
+		"shortSL": model.CloseStopLoss,
+		"shortTP": model.CloseTakeProfit,
+	}, reasons)
+
+	for _, p := range positions {
+		if p.StopLoss > 0 {
+			require.NoError(t, testListenersRepository.RemoveListenerSL(p))
+		} else {
+			require.NoError(t, testListenersRepository.RemoveListenerTP(p))
+		}
+	}
+}
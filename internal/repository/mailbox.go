@@ -0,0 +1,61 @@
+// Package repository conflating price mailbox
+package repository
+
+import (
+	"expvar"
+	"sync"
+
+	"github.com/OVantsevich/Trading-Service/internal/model"
+)
+
+// fanOutMetrics work lost by consumers which do not keep up, e.g. ticks coalesced into a newer price before they were taken
+var fanOutMetrics = expvar.NewMap("price_fan_out")
+
+// mailbox prices of one listener, the latest price of instrument wins, so sender never blocks
+type mailbox struct {
+	mu     sync.Mutex
+	metric string
+	prices map[string]*model.Price
+	closed bool
+	ready  chan struct{}
+}
+
+func newMailbox(metric string) *mailbox {
+	return &mailbox{
+		metric: metric,
+		prices: make(map[string]*model.Price),
+		ready:  make(chan struct{}, 1),
+	}
+}
+
+// put replace not yet taken price of the same instrument
+func (m *mailbox) put(price *model.Price) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.closed {
+		return
+	}
+	if _, ok := m.prices[price.Name]; ok {
+		fanOutMetrics.Add(m.metric+"_coalesced", 1)
+	}
+	p := *price
+	m.prices[price.Name] = &p
+	signal(m.ready)
+}
+
+// take get all prices put since the previous take
+func (m *mailbox) take() map[string]*model.Price {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	prices := m.prices
+	m.prices = make(map[string]*model.Price)
+	return prices
+}
+
+// close drop all next prices, listener doesn't need them anymore
+func (m *mailbox) close() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.closed = true
+	m.prices = make(map[string]*model.Price)
+}
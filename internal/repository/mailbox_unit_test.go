@@ -0,0 +1,40 @@
+package repository
+
+import (
+	"expvar"
+	"testing"
+
+	"github.com/OVantsevich/Trading-Service/internal/model"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMailbox_Conflate(t *testing.T) {
+	before := coalesced("test")
+	box := newMailbox("test")
+
+	box.put(&model.Price{Name: "first", SellingPrice: 1})
+	box.put(&model.Price{Name: "second", SellingPrice: 2})
+	box.put(&model.Price{Name: "first", SellingPrice: 3})
+	<-box.ready
+
+	prices := box.take()
+	require.Len(t, prices, 2)
+	require.Equal(t, 3.0, prices["first"].SellingPrice)
+	require.Equal(t, 2.0, prices["second"].SellingPrice)
+	require.Len(t, box.take(), 0)
+
+	box.close()
+	box.put(&model.Price{Name: "first", SellingPrice: 4})
+	require.Len(t, box.take(), 0)
+
+	require.Equal(t, before+1, coalesced("test"))
+}
+
+func coalesced(metric string) int64 {
+	v, ok := fanOutMetrics.Get(metric + "_coalesced").(*expvar.Int)
+	if !ok {
+		return 0
+	}
+	return v.Value()
+}
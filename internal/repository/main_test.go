@@ -30,10 +30,19 @@ const (
 var testListenersRepository *ListenersRepository
 var testPNLListenersRepository *PNLListenersRepository
 var testPositionRepository *Position
+var testOrdersListenersRepository *OrdersListenersRepository
+var testOrderRepository *Order
+var testIdempotencyRepository *Idempotency
+var testPaymentOutboxRepository *PaymentOutbox
+var testCommissionRepository *Commission
+var testFinancingRepository *Financing
+var testQuoteRepository *Quote
+var testRiskRepository *Risk
 
 func TestMain(m *testing.M) {
 	testListenersRepository = NewListenersRepository()
-	testPNLListenersRepository = NewPNLListenersRepository()
+	testPNLListenersRepository = NewPNLListenersRepository(LiquidateWorstPNL)
+	testOrdersListenersRepository = NewOrdersListenersRepository()
 
 	pool, err := dockertest.NewPool(testLocalDockerUbuntu)
 	if err != nil {
@@ -84,6 +93,13 @@ func TestMain(m *testing.M) {
 		if retryErr != nil {
 			return retryErr
 		}
+		testOrderRepository = NewOrderRepository(NewPgxWithinTransactionRunner(pgPool))
+		testIdempotencyRepository = NewIdempotencyRepository(NewPgxWithinTransactionRunner(pgPool))
+		testPaymentOutboxRepository = NewPaymentOutboxRepository(NewPgxWithinTransactionRunner(pgPool))
+		testCommissionRepository = NewCommissionRepository(NewPgxWithinTransactionRunner(pgPool))
+		testFinancingRepository = NewFinancingRepository(NewPgxWithinTransactionRunner(pgPool))
+		testQuoteRepository = NewQuoteRepository(NewPgxWithinTransactionRunner(pgPool))
+		testRiskRepository = NewRiskRepository(NewPgxWithinTransactionRunner(pgPool))
 		return nil
 	}); err != nil {
 		logrus.Fatalf("Could not connect to postgres: %s", err)
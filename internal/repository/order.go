@@ -0,0 +1,128 @@
+// Package repository order
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/OVantsevich/Trading-Service/internal/model"
+)
+
+// Order postgres entity
+type Order struct {
+	PgxWithinTransactionRunner
+}
+
+// NewOrderRepository creating new Order repository
+func NewOrderRepository(p PgxWithinTransactionRunner) *Order {
+	return &Order{PgxWithinTransactionRunner: p}
+}
+
+// CreateOrder create limit order
+func (o *Order) CreateOrder(ctx context.Context, order *model.Order) (*model.Order, error) {
+	row := o.QueryRow(ctx,
+		`insert into orders (id, "user", "name", amount, price, short_position, status, created, updated) values ($1, $2, $3, $4, $5, $6, $7, $8, $9) returning id;`,
+		order.ID, order.User, order.Name, order.Amount, order.Price, order.ShortPosition, order.Status, order.Created, order.Updated)
+	err := row.Scan(&order.ID)
+	if err != nil {
+		return nil, fmt.Errorf("order - CreateOrder - Scan: %w", err)
+	}
+
+	return order, nil
+}
+
+// GetUserOrders get orders by user id
+func (o *Order) GetUserOrders(ctx context.Context, userID string) ([]*model.Order, error) {
+	rows, err := o.Query(ctx, `select id, "name", amount, price, short_position, status, position_id, created, updated
+									from orders where "user" = $1`, userID)
+	if err != nil {
+		return nil, fmt.Errorf("order - GetUserOrders - Query: %w", err)
+	}
+	defer rows.Close()
+
+	var result []*model.Order
+	for rows.Next() {
+		order := &model.Order{
+			User: userID,
+		}
+		err = rows.Scan(&order.ID, &order.Name, &order.Amount, &order.Price, &order.ShortPosition, &order.Status, &order.PositionID, &order.Created, &order.Updated)
+		if err != nil {
+			return nil, fmt.Errorf("order - GetUserOrders - Scan: %w", err)
+		}
+		result = append(result, order)
+	}
+
+	return result, nil
+}
+
+// GetPendingOrders get all orders waiting for their limit price
+func (o *Order) GetPendingOrders(ctx context.Context) ([]*model.Order, error) {
+	rows, err := o.Query(ctx, `select id, "user", "name", amount, price, short_position, status, position_id, created, updated
+									from orders where status = $1`, model.OrderPending)
+	if err != nil {
+		return nil, fmt.Errorf("order - GetPendingOrders - Query: %w", err)
+	}
+	defer rows.Close()
+
+	var result []*model.Order
+	for rows.Next() {
+		order := &model.Order{}
+		err = rows.Scan(&order.ID, &order.User, &order.Name, &order.Amount, &order.Price, &order.ShortPosition, &order.Status, &order.PositionID, &order.Created, &order.Updated)
+		if err != nil {
+			return nil, fmt.Errorf("order - GetPendingOrders - Scan: %w", err)
+		}
+		result = append(result, order)
+	}
+
+	return result, nil
+}
+
+// CancelOrder cancel pending order
+func (o *Order) CancelOrder(ctx context.Context, id string, updated time.Time) error {
+	tag, err := o.Exec(ctx, `update orders set status=$1, updated=$2 where id=$3 and status=$4;`,
+		model.OrderCanceled, updated, id, model.OrderPending)
+	if err != nil {
+		return fmt.Errorf("order - CancelOrder - Exec: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("order - CancelOrder: pending order with this id doesn't exist")
+	}
+
+	return nil
+}
+
+// FillOrder mark pending order as filled
+func (o *Order) FillOrder(ctx context.Context, id string, updated time.Time) (*model.Order, error) {
+	order := &model.Order{ID: id, Status: model.OrderFilled}
+	row := o.QueryRow(ctx, `update orders set status=$1, updated=$2 where id=$3 and status=$4 returning "user", "name", amount, price, short_position;`,
+		model.OrderFilled, updated, id, model.OrderPending)
+	err := row.Scan(&order.User, &order.Name, &order.Amount, &order.Price, &order.ShortPosition)
+	if err != nil {
+		return nil, fmt.Errorf("order - FillOrder - Scan: %w", err)
+	}
+
+	return order, nil
+}
+
+// SetOrderPosition link filled order with opened position
+func (o *Order) SetOrderPosition(ctx context.Context, id, positionID string, updated time.Time) error {
+	_, err := o.Exec(ctx, `update orders set position_id=$1, updated=$2 where id=$3;`,
+		positionID, updated, id)
+	if err != nil {
+		return fmt.Errorf("order - SetOrderPosition - Exec: %w", err)
+	}
+
+	return nil
+}
+
+// FailOrder mark pending order which position couldn't be opened as failed
+func (o *Order) FailOrder(ctx context.Context, id string, updated time.Time) error {
+	_, err := o.Exec(ctx, `update orders set status=$1, updated=$2 where id=$3 and status=$4;`,
+		model.OrderFailed, updated, id, model.OrderPending)
+	if err != nil {
+		return fmt.Errorf("order - FailOrder - Exec: %w", err)
+	}
+
+	return nil
+}
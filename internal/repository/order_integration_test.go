@@ -0,0 +1,62 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/OVantsevich/Trading-Service/internal/model"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOrder_Create_Cancel_Fill(t *testing.T) {
+	ctx := context.Background()
+	order := &model.Order{
+		ID:      uuid.NewString(),
+		User:    uuid.NewString(),
+		Name:    "name",
+		Amount:  100,
+		Price:   10,
+		Status:  model.OrderPending,
+		Created: time.Now(),
+		Updated: time.Now(),
+	}
+
+	_, err := testOrderRepository.CreateOrder(ctx, order)
+	require.NoError(t, err)
+	notify, err := testPositionRepository.GetNotification(ctx)
+	require.NoError(t, err)
+	require.Equal(t, order.ID, notify.Order.ID)
+
+	_, err = testOrderRepository.CreateOrder(ctx, order)
+	require.Error(t, err)
+
+	filled, err := testOrderRepository.FillOrder(ctx, order.ID, time.Now())
+	require.NoError(t, err)
+	require.Equal(t, order.Amount, filled.Amount)
+	require.Equal(t, order.User, filled.User)
+	_, err = testPositionRepository.GetNotification(ctx)
+	require.NoError(t, err)
+
+	_, err = testOrderRepository.FillOrder(ctx, order.ID, time.Now())
+	require.Error(t, err)
+	err = testOrderRepository.CancelOrder(ctx, order.ID, time.Now())
+	require.Error(t, err)
+
+	order.ID = uuid.NewString()
+	_, err = testOrderRepository.CreateOrder(ctx, order)
+	require.NoError(t, err)
+	_, err = testPositionRepository.GetNotification(ctx)
+	require.NoError(t, err)
+
+	err = testOrderRepository.CancelOrder(ctx, order.ID, time.Now())
+	require.NoError(t, err)
+	_, err = testPositionRepository.GetNotification(ctx)
+	require.NoError(t, err)
+
+	orders, err := testOrderRepository.GetUserOrders(ctx, order.User)
+	require.NoError(t, err)
+	require.Len(t, orders, 2)
+}
@@ -0,0 +1,108 @@
+// Package repository orders listeners repository
+package repository
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/OVantsevich/Trading-Service/internal/model"
+)
+
+// OrdersListenersRepository limit orders listeners repository
+type OrdersListenersRepository struct {
+	mu           sync.RWMutex
+	filledOrders chan *model.Order
+	listeners    map[string]map[string]*listenerMailbox
+}
+
+// listenerMailbox prices of listener goroutine which stops on done
+type listenerMailbox struct {
+	*mailbox
+	done chan struct{}
+}
+
+// NewOrdersListenersRepository constructor
+func NewOrdersListenersRepository() *OrdersListenersRepository {
+	return &OrdersListenersRepository{
+		filledOrders: make(chan *model.Order),
+		listeners:    make(map[string]map[string]*listenerMailbox),
+	}
+}
+
+// CreateListener create limit order listener, replaces existing listener of the order
+func (l *OrdersListenersRepository) CreateListener(ctx context.Context, order *model.Order) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	lis, ok := l.listeners[order.Name]
+	if !ok {
+		l.listeners[order.Name] = make(map[string]*listenerMailbox)
+	}
+	if box, ok := lis[order.ID]; ok {
+		box.close()
+		close(box.done)
+	}
+	box := &listenerMailbox{mailbox: newMailbox("orders"), done: make(chan struct{})}
+	ord := *order
+	go orderListener(ctx, box, l.filledOrders, &ord)
+	l.listeners[order.Name][order.ID] = box
+	return nil
+}
+
+// RemoveListener remove limit order listener
+func (l *OrdersListenersRepository) RemoveListener(order *model.Order) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	box, ok := l.listeners[order.Name][order.ID]
+	if !ok {
+		return fmt.Errorf("ordersListenersRepository - RemoveListener: listener with this name and orderID does't exist")
+	}
+	box.close()
+	close(box.done)
+	delete(l.listeners[order.Name], order.ID)
+	return nil
+}
+
+// SendPrices sending prices for all limit order listeners without blocking
+func (l *OrdersListenersRepository) SendPrices(prices []*model.Price) {
+	l.mu.RLock()
+	for _, p := range prices {
+		for _, box := range l.listeners[p.Name] {
+			box.put(p)
+		}
+	}
+	l.mu.RUnlock()
+}
+
+// FillOrder sync await for order which limit price was reached
+func (l *OrdersListenersRepository) FillOrder(ctx context.Context) (*model.Order, error) {
+	select {
+	case <-ctx.Done():
+		return nil, fmt.Errorf("ordersListenersRepository - FillOrder: context canceld")
+	case order := <-l.filledOrders:
+		return order, nil
+	}
+}
+
+func orderListener(ctx context.Context, box *listenerMailbox, cout chan *model.Order, order *model.Order) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-box.done:
+			return
+		case <-box.ready:
+			price, ok := box.take()[order.Name]
+			if !ok || !order.Reached(price) {
+				continue
+			}
+			box.close()
+			select {
+			case <-ctx.Done():
+			case <-box.done:
+			case cout <- order:
+			}
+			return
+		}
+	}
+}
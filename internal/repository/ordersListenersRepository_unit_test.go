@@ -0,0 +1,71 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/OVantsevich/Trading-Service/internal/model"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOrdersListenersRepository_Create_Remove(t *testing.T) {
+	ctx := context.Background()
+	order := &model.Order{
+		ID:   uuid.NewString(),
+		Name: uuid.NewString(),
+	}
+
+	err := testOrdersListenersRepository.CreateListener(ctx, order)
+	require.NoError(t, err)
+	err = testOrdersListenersRepository.CreateListener(ctx, order)
+	require.NoError(t, err)
+	err = testOrdersListenersRepository.RemoveListener(order)
+	require.NoError(t, err)
+	err = testOrdersListenersRepository.RemoveListener(order)
+	require.Error(t, err)
+}
+
+func TestOrdersListenersRepository_SendPrices(t *testing.T) {
+	ctx := context.Background()
+	long := &model.Order{
+		ID:    uuid.NewString(),
+		Name:  uuid.NewString(),
+		Price: 100,
+	}
+	short := &model.Order{
+		ID:            uuid.NewString(),
+		Name:          long.Name,
+		Price:         200,
+		ShortPosition: true,
+	}
+
+	err := testOrdersListenersRepository.CreateListener(ctx, long)
+	require.NoError(t, err)
+	err = testOrdersListenersRepository.CreateListener(ctx, short)
+	require.NoError(t, err)
+
+	testOrdersListenersRepository.SendPrices([]*model.Price{{Name: long.Name, PurchasePrice: 150}})
+
+	timeout, cancel := context.WithTimeout(ctx, time.Millisecond*100)
+	_, err = testOrdersListenersRepository.FillOrder(timeout)
+	cancel()
+	require.Error(t, err)
+
+	testOrdersListenersRepository.SendPrices([]*model.Price{{Name: long.Name, PurchasePrice: 99}})
+	order, err := testOrdersListenersRepository.FillOrder(ctx)
+	require.NoError(t, err)
+	require.Equal(t, long.ID, order.ID)
+
+	testOrdersListenersRepository.SendPrices([]*model.Price{{Name: long.Name, PurchasePrice: 201}})
+	order, err = testOrdersListenersRepository.FillOrder(ctx)
+	require.NoError(t, err)
+	require.Equal(t, short.ID, order.ID)
+
+	err = testOrdersListenersRepository.RemoveListener(long)
+	require.NoError(t, err)
+	err = testOrdersListenersRepository.RemoveListener(short)
+	require.NoError(t, err)
+}
@@ -0,0 +1,109 @@
+// Package repository payment outbox
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/OVantsevich/Trading-Service/internal/model"
+)
+
+// PaymentOutbox postgres entity
+type PaymentOutbox struct {
+	PgxWithinTransactionRunner
+}
+
+// NewPaymentOutboxRepository creating new PaymentOutbox repository
+func NewPaymentOutboxRepository(p PgxWithinTransactionRunner) *PaymentOutbox {
+	return &PaymentOutbox{PgxWithinTransactionRunner: p}
+}
+
+// CreatePaymentCommand store payment command
+func (p *PaymentOutbox) CreatePaymentCommand(ctx context.Context, command *model.PaymentCommand) error {
+	_, err := p.Exec(ctx,
+		`insert into payment_outbox (id, position_id, "user", operation, amount, reason, snapshot, status, next_attempt, created, updated)
+			values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11);`,
+		command.ID, command.PositionID, command.User, command.Operation, command.Amount, command.Reason, command.Snapshot, command.Status,
+		command.NextAttempt, command.Created, command.Updated)
+	if err != nil {
+		return fmt.Errorf("paymentOutbox - CreatePaymentCommand - Exec: %w", err)
+	}
+
+	return nil
+}
+
+// GetDuePaymentCommands lock pending, in flight with expired lease and reconciled commands which are due
+// and have no earlier pending or in flight command of the same position
+func (p *PaymentOutbox) GetDuePaymentCommands(ctx context.Context, now time.Time, limit int) ([]*model.PaymentCommand, error) {
+	rows, err := p.Query(ctx, `select c.id, c.seq, c.position_id, c."user", c.operation, c.amount, c.reason, c.snapshot, c.status, c.attempts, c.last_error,
+									c.next_attempt, c.created, c.updated
+									from payment_outbox c
+									where c.status in ($1, $2, $3) and c.next_attempt <= $4
+									  and not exists(select 1 from payment_outbox e
+													 where e.position_id = c.position_id and e.status in ($1, $2) and e.seq < c.seq)
+									order by c.seq
+									limit $5 for update skip locked`, model.PaymentPending, model.PaymentInFlight, model.PaymentReconcile, now, limit)
+	if err != nil {
+		return nil, fmt.Errorf("paymentOutbox - GetDuePaymentCommands - Query: %w", err)
+	}
+	defer rows.Close()
+
+	var result []*model.PaymentCommand
+	for rows.Next() {
+		command := &model.PaymentCommand{}
+		err = rows.Scan(&command.ID, &command.Seq, &command.PositionID, &command.User, &command.Operation, &command.Amount, &command.Reason, &command.Snapshot,
+			&command.Status, &command.Attempts, &command.LastError, &command.NextAttempt, &command.Created, &command.Updated)
+		if err != nil {
+			return nil, fmt.Errorf("paymentOutbox - GetDuePaymentCommands - Scan: %w", err)
+		}
+		result = append(result, command)
+	}
+
+	return result, nil
+}
+
+// GetPositionPaymentCommands get pending commands of position in execution order
+func (p *PaymentOutbox) GetPositionPaymentCommands(ctx context.Context, positionID string) ([]*model.PaymentCommand, error) {
+	rows, err := p.Query(ctx, `select id, seq, position_id, "user", operation, amount, reason, snapshot, status, attempts, last_error, next_attempt, created, updated
+									from payment_outbox where position_id = $1 and status = $2 order by seq`, positionID, model.PaymentPending)
+	if err != nil {
+		return nil, fmt.Errorf("paymentOutbox - GetPositionPaymentCommands - Query: %w", err)
+	}
+	defer rows.Close()
+
+	var result []*model.PaymentCommand
+	for rows.Next() {
+		command := &model.PaymentCommand{}
+		err = rows.Scan(&command.ID, &command.Seq, &command.PositionID, &command.User, &command.Operation, &command.Amount, &command.Reason, &command.Snapshot,
+			&command.Status, &command.Attempts, &command.LastError, &command.NextAttempt, &command.Created, &command.Updated)
+		if err != nil {
+			return nil, fmt.Errorf("paymentOutbox - GetPositionPaymentCommands - Scan: %w", err)
+		}
+		result = append(result, command)
+	}
+
+	return result, nil
+}
+
+// UpdatePaymentCommand update operation, status and attempts of command
+func (p *PaymentOutbox) UpdatePaymentCommand(ctx context.Context, command *model.PaymentCommand) error {
+	_, err := p.Exec(ctx, `update payment_outbox set operation=$1, amount=$2, status=$3, attempts=$4, last_error=$5, next_attempt=$6, updated=$7 where id=$8;`,
+		command.Operation, command.Amount, command.Status, command.Attempts, command.LastError, command.NextAttempt, command.Updated, command.ID)
+	if err != nil {
+		return fmt.Errorf("paymentOutbox - UpdatePaymentCommand - Exec: %w", err)
+	}
+
+	return nil
+}
+
+// CancelPaymentCommands cancel pending commands of position
+func (p *PaymentOutbox) CancelPaymentCommands(ctx context.Context, positionID string, updated time.Time) error {
+	_, err := p.Exec(ctx, `update payment_outbox set status=$1, updated=$2 where position_id=$3 and status=$4;`,
+		model.PaymentCanceled, updated, positionID, model.PaymentPending)
+	if err != nil {
+		return fmt.Errorf("paymentOutbox - CancelPaymentCommands - Exec: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,95 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/OVantsevich/Trading-Service/internal/model"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPaymentOutbox_Create_GetDue_Update(t *testing.T) {
+	ctx := context.Background()
+	positionID := uuid.NewString()
+	now := time.Now()
+	commands := []*model.PaymentCommand{
+		{
+			ID:          uuid.NewString(),
+			PositionID:  positionID,
+			User:        uuid.NewString(),
+			Operation:   model.PaymentDecrease,
+			Amount:      100,
+			Reason:      model.PaymentOpen,
+			Status:      model.PaymentPending,
+			NextAttempt: now,
+			Created:     now,
+			Updated:     now,
+		},
+		{
+			ID:          uuid.NewString(),
+			PositionID:  positionID,
+			User:        uuid.NewString(),
+			Operation:   model.PaymentDecrease,
+			Amount:      50,
+			Reason:      model.PaymentScale,
+			Snapshot:    &model.Position{ID: positionID, Amount: 10, PurchasePrice: 10},
+			Status:      model.PaymentPending,
+			NextAttempt: now,
+			Created:     now,
+			Updated:     now,
+		},
+	}
+	for _, c := range commands {
+		err := testPaymentOutboxRepository.CreatePaymentCommand(ctx, c)
+		require.NoError(t, err)
+	}
+
+	due, err := testPaymentOutboxRepository.GetDuePaymentCommands(ctx, time.Now(), 10)
+	require.NoError(t, err)
+	var ids []string
+	for _, c := range due {
+		if c.PositionID == positionID {
+			ids = append(ids, c.ID)
+		}
+	}
+	require.Equal(t, []string{commands[0].ID}, ids)
+
+	pending, err := testPaymentOutboxRepository.GetPositionPaymentCommands(ctx, positionID)
+	require.NoError(t, err)
+	require.Len(t, pending, 2)
+	require.Equal(t, commands[1].Snapshot.Amount, pending[1].Snapshot.Amount)
+
+	commands[0].Status = model.PaymentInFlight
+	commands[0].Attempts = 1
+	commands[0].NextAttempt = time.Now().Add(time.Minute)
+	err = testPaymentOutboxRepository.UpdatePaymentCommand(ctx, commands[0])
+	require.NoError(t, err)
+	due, err = testPaymentOutboxRepository.GetDuePaymentCommands(ctx, time.Now(), 10)
+	require.NoError(t, err)
+	for _, c := range due {
+		require.NotEqual(t, positionID, c.PositionID)
+	}
+
+	commands[0].Status = model.PaymentDone
+	err = testPaymentOutboxRepository.UpdatePaymentCommand(ctx, commands[0])
+	require.NoError(t, err)
+
+	due, err = testPaymentOutboxRepository.GetDuePaymentCommands(ctx, time.Now(), 10)
+	require.NoError(t, err)
+	ids = nil
+	for _, c := range due {
+		if c.PositionID == positionID {
+			ids = append(ids, c.ID)
+		}
+	}
+	require.Equal(t, []string{commands[1].ID}, ids)
+
+	err = testPaymentOutboxRepository.CancelPaymentCommands(ctx, positionID, time.Now())
+	require.NoError(t, err)
+	pending, err = testPaymentOutboxRepository.GetPositionPaymentCommands(ctx, positionID)
+	require.NoError(t, err)
+	require.Len(t, pending, 0)
+}
@@ -6,8 +6,12 @@ import (
 	"fmt"
 
 	psProto "github.com/OVantsevich/Payment-Service/proto"
+	"google.golang.org/grpc/metadata"
 )
 
+// idempotencyKeyHeader metadata by which payment service applies repeated call with the same key only once
+const idempotencyKeyHeader = "idempotency-key"
+
 // PaymentService entity
 type PaymentService struct {
 	client psProto.PaymentServiceClient
@@ -28,8 +32,18 @@ func (p *PaymentService) GetAccountID(ctx context.Context, userID string) (strin
 	return response.Account.ID, nil
 }
 
-// IncreaseAmount increase amount
-func (p *PaymentService) IncreaseAmount(ctx context.Context, accountID string, amount float64) error {
+// GetBalance get free balance of user account
+func (p *PaymentService) GetBalance(ctx context.Context, userID string) (float64, error) {
+	response, err := p.client.GetAccount(ctx, &psProto.GetAccountRequest{UserID: userID})
+	if err != nil {
+		return 0, fmt.Errorf("paymentService - GetBalance - GetAccount: %w", err)
+	}
+	return response.Account.Amount, nil
+}
+
+// IncreaseAmount increase amount, repeated call with the same idempotency key is applied once
+func (p *PaymentService) IncreaseAmount(ctx context.Context, accountID string, amount float64, idempotencyKey string) error {
+	ctx = metadata.AppendToOutgoingContext(ctx, idempotencyKeyHeader, idempotencyKey)
 	_, err := p.client.IncreaseAmount(ctx, &psProto.AmountRequest{AccountID: accountID, Amount: amount})
 	if err != nil {
 		return fmt.Errorf("paymentService - IncreaseAmount - IncreaseAmount: %w", err)
@@ -37,8 +51,9 @@ func (p *PaymentService) IncreaseAmount(ctx context.Context, accountID string, a
 	return nil
 }
 
-// DecreaseAmount decrease amount
-func (p *PaymentService) DecreaseAmount(ctx context.Context, accountID string, amount float64) error {
+// DecreaseAmount decrease amount, repeated call with the same idempotency key is applied once
+func (p *PaymentService) DecreaseAmount(ctx context.Context, accountID string, amount float64, idempotencyKey string) error {
+	ctx = metadata.AppendToOutgoingContext(ctx, idempotencyKeyHeader, idempotencyKey)
 	_, err := p.client.DecreaseAmount(ctx, &psProto.AmountRequest{AccountID: accountID, Amount: amount})
 	if err != nil {
 		return fmt.Errorf("paymentService - DecreaseAmount - DecreaseAmount: %w", err)
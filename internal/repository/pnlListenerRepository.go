@@ -3,143 +3,259 @@ package repository
 import (
 	"context"
 	"fmt"
+	"sort"
 	"sync"
+	"time"
 
 	"github.com/OVantsevich/Trading-Service/internal/model"
 )
 
+// LiquidateWorstPNL liquidate position with the lowest unrealized pnl first
+const LiquidateWorstPNL = "worst_pnl"
+
+// LiquidateWorstRatio liquidate position with the lowest equity to margin ratio first
+const LiquidateWorstRatio = "worst_ratio"
+
+// LiquidateLargest liquidate position with the largest notional first
+const LiquidateLargest = "largest"
+
 type newPosition struct {
 	newPos       *model.Position
 	currentPrice *model.Price
 }
 
+// pnlCommand change of portfolio tracked by pnl listener, exactly one field is set
+type pnlCommand struct {
+	add     *newPosition
+	update  *model.Position
+	remove  *model.Position
+	balance *float64
+}
+
+// commandQueue unbounded queue of listener commands, so sender never blocks even under lock
+type commandQueue struct {
+	mu       sync.Mutex
+	commands []*pnlCommand
+	ready    chan struct{}
+}
+
+func newCommandQueue() *commandQueue {
+	return &commandQueue{ready: make(chan struct{}, 1)}
+}
+
+// push append command and wake listener
+func (q *commandQueue) push(command *pnlCommand) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.commands = append(q.commands, command)
+	signal(q.ready)
+}
+
+// take get all commands pushed since the previous take in push order
+func (q *commandQueue) take() []*pnlCommand {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	commands := q.commands
+	q.commands = nil
+	return commands
+}
+
 type userListener struct {
-	removePosition chan *model.Position
-	addPosition    chan *newPosition
-	updatePrices   chan *model.Price
+	user         string
+	feed         *portfolioFeed
+	refresh      chan struct{}
+	commands     *commandQueue
+	updatePrices *mailbox
 }
 
 // PNLListenersRepository listeners repository
 type PNLListenersRepository struct {
 	mu              sync.RWMutex
 	pnlDown         chan *model.Position
-	listenersPrices map[string]map[string]chan *model.Price
+	listenersPrices map[string]map[string]*mailbox
 	userListeners   map[string]*userListener
+	balances        map[string]float64
+	feeds           map[string]*portfolioFeed
+	worse           func(a, b *model.Position, pa, pb *model.Price) bool
 }
 
-// NewPNLListenersRepository constructor
-func NewPNLListenersRepository() *PNLListenersRepository {
+// NewPNLListenersRepository constructor, policy chooses which position of portfolio is liquidated first
+func NewPNLListenersRepository(policy string) *PNLListenersRepository {
 	pnlDown := make(chan *model.Position, 1)
-	listenersPrices := make(map[string]map[string]chan *model.Price)
+	listenersPrices := make(map[string]map[string]*mailbox)
 	userListeners := make(map[string]*userListener)
 	return &PNLListenersRepository{
 		pnlDown:         pnlDown,
 		listenersPrices: listenersPrices,
 		userListeners:   userListeners,
+		balances:        make(map[string]float64),
+		feeds:           make(map[string]*portfolioFeed),
+		worse:           liquidationPolicy(policy),
+	}
+}
+
+// liquidationPolicy compare positions by policy, unknown policy falls back to the worst pnl
+func liquidationPolicy(policy string) func(a, b *model.Position, pa, pb *model.Price) bool {
+	switch policy {
+	case LiquidateWorstRatio:
+		return func(a, b *model.Position, pa, pb *model.Price) bool {
+			return equity(a, pa)/a.InitialMargin() < equity(b, pb)/b.InitialMargin()
+		}
+	case LiquidateLargest:
+		return func(a, b *model.Position, pa, pb *model.Price) bool {
+			return a.Amount*pa.SellingPrice > b.Amount*pb.SellingPrice
+		}
+	default:
+		return func(a, b *model.Position, pa, pb *model.Price) bool {
+			return pnl(a, pa) < pnl(b, pb)
+		}
 	}
 }
 
 // CreateListener create pnl listener
 func (l *PNLListenersRepository) createListener(ctx context.Context, positions []*model.Position, prices map[string]*model.Price) error {
-	l.userListeners[positions[0].User] = &userListener{
-		removePosition: make(chan *model.Position),
-		addPosition:    make(chan *newPosition),
-		updatePrices:   make(chan *model.Price),
-	}
-
-	var ok bool
-	for _, n := range positions {
-		if n.ShortPosition {
-			_, ok = l.listenersPrices[n.Name]
-			if !ok {
-				l.listenersPrices[n.Name] = make(map[string]chan *model.Price)
-			}
-			l.listenersPrices[n.Name][n.User] = l.userListeners[n.User].updatePrices
-		}
+	lis := &userListener{
+		user:         positions[0].User,
+		feed:         l.feed(positions[0].User),
+		refresh:      make(chan struct{}, 1),
+		commands:     newCommandQueue(),
+		updatePrices: newMailbox("pnl"),
 	}
+	l.userListeners[positions[0].User] = lis
 
-	go pnlListener(ctx, l.userListeners[positions[0].User], l.pnlDown)
+	// positions are queued before start, so listener checks the whole portfolio at once
+	l.addPositions(lis, positions, prices)
+	go pnlListener(ctx, lis, l.balances[positions[0].User], l.worse, l.pnlDown)
+	return nil
+}
 
+// addPositions subscribe listener for prices and queue positions to it, must be called under lock
+func (l *PNLListenersRepository) addPositions(lis *userListener, positions []*model.Position, prices map[string]*model.Price) {
 	for _, p := range positions {
-		if p.ShortPosition {
-			pos := *p
-			price := *prices[pos.Name]
-			l.userListeners[positions[0].User].addPosition <- &newPosition{
-				newPos:       &pos,
-				currentPrice: &price,
-			}
+		_, ok := l.listenersPrices[p.Name]
+		if !ok {
+			l.listenersPrices[p.Name] = make(map[string]*mailbox)
 		}
+		l.listenersPrices[p.Name][p.User] = lis.updatePrices
+
+		pos := *p
+		price := *prices[pos.Name]
+		lis.commands.push(&pnlCommand{add: &newPosition{
+			newPos:       &pos,
+			currentPrice: &price,
+		}})
 	}
-	return nil
 }
 
-// AddPositions add position
+// feed get or create portfolio feed of user, must be called under lock
+func (l *PNLListenersRepository) feed(userID string) *portfolioFeed {
+	f, ok := l.feeds[userID]
+	if !ok {
+		f = newPortfolioFeed()
+		l.feeds[userID] = f
+	}
+	return f
+}
+
+// SubscribePortfolio subscribe for snapshots of user portfolio published on every relevant tick and position change,
+// user without tracked positions gets snapshot of free balance only, returned function unsubscribes
+func (l *PNLListenersRepository) SubscribePortfolio(userID string) (<-chan *model.Portfolio, func()) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	f := l.feed(userID)
+	lis, ok := l.userListeners[userID]
+	var initial *model.Portfolio
+	if !ok {
+		initial = snapshot(userID, nil, nil, l.balances[userID])
+	}
+	c, cancel := f.subscribe(initial)
+	if ok {
+		signal(lis.refresh)
+	}
+	return c, func() {
+		l.mu.Lock()
+		defer l.mu.Unlock()
+		cancel()
+		l.pruneFeed(userID, f)
+	}
+}
+
+// pruneFeed remove feed without subscribers unless user listener publishes to it, must be called under lock
+func (l *PNLListenersRepository) pruneFeed(userID string, f *portfolioFeed) {
+	if _, ok := l.userListeners[userID]; ok || !f.empty() || l.feeds[userID] != f {
+		return
+	}
+	delete(l.feeds, userID)
+}
+
+// AddPositions add positions of one user, already tracked position is replaced
 func (l *PNLListenersRepository) AddPositions(ctx context.Context, positions []*model.Position, prices map[string]*model.Price) error {
+	for _, p := range positions {
+		if _, ok := prices[p.Name]; !ok {
+			return fmt.Errorf("PNLListenersRepository - AddPositions: no price of %s", p.Name)
+		}
+	}
 	l.mu.Lock()
-	_, ok := l.userListeners[positions[0].User]
+	defer l.mu.Unlock()
+	lis, ok := l.userListeners[positions[0].User]
 	if !ok {
 		err := l.createListener(ctx, positions, prices)
 		if err != nil {
 			return fmt.Errorf("PNLListenersRepository - AddPositions - createListener: %w", err)
 		}
-	} else {
-		for _, p := range positions {
-			if p.ShortPosition {
-				_, ok = l.listenersPrices[p.Name]
-				if !ok {
-					l.listenersPrices[p.Name] = make(map[string]chan *model.Price)
-				}
-				l.listenersPrices[p.Name][p.User] = l.userListeners[p.User].updatePrices
+		return nil
+	}
+	l.addPositions(lis, positions, prices)
+	return nil
+}
 
-				pos := *p
-				price := *prices[pos.Name]
-				l.userListeners[positions[0].User].addPosition <- &newPosition{
-					newPos:       &pos,
-					currentPrice: &price,
-				}
-			}
-		}
+// UpdateBalance set free account balance of user which covers portfolio losses
+func (l *PNLListenersRepository) UpdateBalance(userID string, balance float64) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.balances[userID] = balance
+	lis, ok := l.userListeners[userID]
+	if ok {
+		lis.commands.push(&pnlCommand{balance: &balance})
 	}
-	l.mu.Unlock()
 	return nil
 }
 
 // RemovePosition remove position
 func (l *PNLListenersRepository) RemovePosition(position *model.Position) error {
-	if position.ShortPosition {
-		l.mu.Lock()
-		_, ok := l.userListeners[position.User]
-		if !ok {
-			return fmt.Errorf("PNLListenersRepository - RemovePosition: listener for this user does not exist")
-		} else {
-			_, ok = l.listenersPrices[position.Name]
-			if !ok {
-				return fmt.Errorf("PNLListenersRepository - RemovePosition: no position for this name exists")
-			}
-			_, ok = l.listenersPrices[position.Name][position.User]
-			if ok {
-				delete(l.listenersPrices[position.Name], position.User)
-			}
-			l.userListeners[position.User].removePosition <- position
-		}
-		l.mu.Unlock()
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	lis, ok := l.userListeners[position.User]
+	if !ok {
+		return fmt.Errorf("PNLListenersRepository - RemovePosition: listener for this user does not exist")
 	}
+	_, ok = l.listenersPrices[position.Name]
+	if !ok {
+		return fmt.Errorf("PNLListenersRepository - RemovePosition: no position for this name exists")
+	}
+	lis.commands.push(&pnlCommand{remove: position})
+	return nil
+}
+
+// UpdatePosition update amount and purchase price of tracked position
+func (l *PNLListenersRepository) UpdatePosition(position *model.Position) error {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	lis, ok := l.userListeners[position.User]
+	if !ok {
+		return fmt.Errorf("PNLListenersRepository - UpdatePosition: listener for this user does not exist")
+	}
+	pos := *position
+	lis.commands.push(&pnlCommand{update: &pos})
 	return nil
 }
 
-// SendPricesPNL sending prices for all users listeners
+// SendPricesPNL sending prices for all users listeners without blocking, not yet handled price of instrument is replaced
 func (l *PNLListenersRepository) SendPricesPNL(prices []*model.Price) {
 	l.mu.RLock()
 	for _, p := range prices {
 		for _, lis := range l.listenersPrices[p.Name] {
-			//go func(c chan *model.Price, inp *model.Price) {
-			//	c <- inp
-			//}(lis, &model.Price{
-			//	Name:          p.Name,
-			//	SellingPrice:  p.SellingPrice,
-			//	PurchasePrice: p.PurchasePrice,
-			//})
-			lis <- &(*p)
+			lis.put(p)
 		}
 	}
 	l.mu.RUnlock()
@@ -155,85 +271,188 @@ func (l *PNLListenersRepository) ClosePosition(ctx context.Context) (*model.Posi
 	}
 }
 
-func pnlListener(ctx context.Context, userLis *userListener, cout chan *model.Position) {
-	var sum float64
-
-	var ok bool
+func pnlListener(ctx context.Context, userLis *userListener, balance float64, worse func(a, b *model.Position, pa, pb *model.Price) bool,
+	cout chan *model.Position) {
 	var prices = make(map[string]*model.Price)
 	var positions = make(map[string]*model.Position)
 
-	var updPrices *model.Price
-	var removePosition *model.Position
-	var addPosition *newPosition
 	for {
 		select {
 		case <-ctx.Done():
 			return
-		case updPrices, ok = <-userLis.updatePrices:
-			if !ok {
-				return
-			}
-			price, ok := prices[updPrices.Name]
-			if ok {
-				price.SellingPrice = updPrices.SellingPrice
-				price.PurchasePrice = updPrices.PurchasePrice
-			} else {
-				continue
-			}
-			for {
-				sum = recalculate(positions, prices)
-				if sum < 0.0 {
-					for _, pos := range positions {
-						cout <- pos
-						delete(positions, pos.Name)
-						delete(prices, pos.Name)
-						break
-					}
-				} else {
-					break
-				}
-			}
-		case addPosition, ok = <-userLis.addPosition:
-			if !ok {
-				return
-			}
-			_, ok = positions[addPosition.newPos.Name]
-			if !ok {
-				positions[addPosition.newPos.Name] = addPosition.newPos
-				prices[addPosition.newPos.Name] = addPosition.currentPrice
-			}
-			for {
-				sum = recalculate(positions, prices)
-				if sum < 0.0 {
-					for _, pos := range positions {
-						cout <- pos
-						delete(positions, pos.Name)
-						delete(prices, pos.Name)
-						break
-					}
-				} else {
-					break
+		case <-userLis.updatePrices.ready:
+			// commands queued before the prices were sent must be applied first, so new positions get the tick
+			updated := applyCommands(userLis.commands, positions, prices, &balance)
+			for name, updPrices := range userLis.updatePrices.take() {
+				price, ok := prices[name]
+				if ok {
+					price.SellingPrice = updPrices.SellingPrice
+					price.PurchasePrice = updPrices.PurchasePrice
+					updated = true
 				}
 			}
-		case removePosition, ok = <-userLis.removePosition:
-			if !ok {
-				return
+			if updated {
+				liquidate(positions, prices, &balance, worse, cout)
+				publish(userLis, positions, prices, balance)
 			}
-			_, ok = positions[removePosition.Name]
-			if ok {
-				delete(positions, removePosition.Name)
-				delete(prices, removePosition.Name)
+		case <-userLis.refresh:
+			publish(userLis, positions, prices, balance)
+		case <-userLis.commands.ready:
+			if applyCommands(userLis.commands, positions, prices, &balance) {
+				liquidate(positions, prices, &balance, worse, cout)
 			}
+			publish(userLis, positions, prices, balance)
+		}
+	}
+}
+
+// applyCommands apply all queued commands, returns true if any of them can bring portfolio closer to liquidation
+func applyCommands(queue *commandQueue, positions map[string]*model.Position, prices map[string]*model.Price, balance *float64) bool {
+	changed := false
+	for _, command := range queue.take() {
+		if applyCommand(command, positions, prices, balance) {
+			changed = true
 		}
 	}
+	return changed
 }
 
+// applyCommand change tracked portfolio, returns false if portfolio can't get closer to liquidation by the change
+func applyCommand(command *pnlCommand, positions map[string]*model.Position, prices map[string]*model.Price, balance *float64) bool {
+	switch {
+	case command.balance != nil:
+		*balance = *command.balance
+	case command.add != nil:
+		positions[command.add.newPos.ID] = command.add.newPos
+		if _, ok := prices[command.add.newPos.Name]; !ok {
+			prices[command.add.newPos.Name] = command.add.currentPrice
+		}
+	case command.update != nil:
+		pos, ok := positions[command.update.ID]
+		if !ok {
+			return false
+		}
+		pos.Amount = command.update.Amount
+		pos.PurchasePrice = command.update.PurchasePrice
+		pos.Margin = command.update.Margin
+		pos.MaintenanceMargin = command.update.MaintenanceMargin
+	case command.remove != nil:
+		delete(positions, command.remove.ID)
+		return false
+	}
+	return true
+}
+
+// publish send snapshot of portfolio to subscribers of user
+func publish(userLis *userListener, positions map[string]*model.Position, prices map[string]*model.Price, balance float64) {
+	if userLis.feed.empty() {
+		return
+	}
+	userLis.feed.publish(snapshot(userLis.user, positions, prices, balance))
+}
+
+// snapshot value positions at current selling prices, positions are ordered by opening time
+func snapshot(userID string, positions map[string]*model.Position, prices map[string]*model.Price, balance float64) *model.Portfolio {
+	portfolio := &model.Portfolio{
+		User:      userID,
+		Positions: make([]*model.PositionPNL, 0, len(positions)),
+		Balance:   balance,
+		Equity:    balance,
+		Updated:   time.Now(),
+	}
+	for _, pos := range positions {
+		price := prices[pos.Name]
+		p := *pos
+		value := &model.PositionPNL{
+			Position:      &p,
+			MarkPrice:     price.SellingPrice,
+			UnrealizedPNL: pnl(pos, price),
+			Equity:        equity(pos, price),
+		}
+		portfolio.Equity += value.Equity
+		portfolio.Positions = append(portfolio.Positions, value)
+	}
+	sort.Slice(portfolio.Positions, func(i, j int) bool {
+		a, b := portfolio.Positions[i].Position, portfolio.Positions[j].Position
+		if !a.Created.Equal(b.Created) {
+			return a.Created.Before(b.Created)
+		}
+		return a.ID < b.ID
+	})
+	return portfolio
+}
+
+// pnl unrealized pnl of position
+func pnl(pos *model.Position, price *model.Price) float64 {
+	if pos.ShortPosition {
+		return pos.Amount * (pos.PurchasePrice - price.SellingPrice)
+	}
+	return pos.Amount * (price.SellingPrice - pos.PurchasePrice)
+}
+
+// equity margin of position with unrealized pnl
+func equity(pos *model.Position, price *model.Price) float64 {
+	return pos.InitialMargin() + pnl(pos, price)
+}
+
+// liquidate send positions to close when equity is below maintenance margin,
+// then while free balance with equity of all positions doesn't cover their maintenance margin the worst position by policy is closed,
+// equity of every closed position is credited to the balance
+func liquidate(positions map[string]*model.Position, prices map[string]*model.Price, balance *float64,
+	worse func(a, b *model.Position, pa, pb *model.Price) bool, cout chan *model.Position) {
+	for id, pos := range positions {
+		if pos.MaintenanceMargin > 0 && equity(pos, prices[pos.Name]) < pos.MaintenanceMargin {
+			pos.LiquidationReason = model.LiquidationMaintenanceMargin
+			pos.TriggerPrice = prices[pos.Name].SellingPrice
+			*balance += equity(pos, prices[pos.Name])
+			cout <- pos
+			delete(positions, id)
+		}
+	}
+	if covered(positions, prices, *balance) {
+		return
+	}
+
+	queue := make([]*model.Position, 0, len(positions))
+	for _, pos := range positions {
+		queue = append(queue, pos)
+	}
+	sort.Slice(queue, func(i, j int) bool {
+		a, b := queue[i], queue[j]
+		if worse(a, b, prices[a.Name], prices[b.Name]) {
+			return true
+		}
+		if worse(b, a, prices[b.Name], prices[a.Name]) {
+			return false
+		}
+		return a.ID < b.ID
+	})
+	for _, pos := range queue {
+		if covered(positions, prices, *balance) {
+			return
+		}
+		pos.LiquidationReason = model.LiquidationNegativePNL
+		pos.TriggerPrice = prices[pos.Name].SellingPrice
+		*balance += equity(pos, prices[pos.Name])
+		cout <- pos
+		delete(positions, pos.ID)
+	}
+}
+
+// covered check if free balance with equity of all positions covers their maintenance margin
+func covered(positions map[string]*model.Position, prices map[string]*model.Price, balance float64) bool {
+	var maintenance float64
+	for _, pos := range positions {
+		maintenance += pos.MaintenanceMargin
+	}
+	return balance+recalculate(positions, prices) >= maintenance
+}
+
+// recalculate equity of all positions
 func recalculate(positions map[string]*model.Position, prices map[string]*model.Price) float64 {
 	var sum float64
 	for _, pos := range positions {
-		if pos.ShortPosition {
-			sum += pos.Amount * (pos.PurchasePrice + pos.PurchasePrice - prices[pos.Name].SellingPrice)
-		}
+		sum += equity(pos, prices[pos.Name])
 	}
 	return sum
 }
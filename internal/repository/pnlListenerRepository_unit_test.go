@@ -28,6 +28,40 @@ func TestPNLListenersRepository_AddPositions(t *testing.T) {
 
 	err := testPNLListenersRepository.AddPositions(ctx, []*model.Position{position}, map[string]*model.Price{position.Name: price})
 	require.NoError(t, err)
+
+	unpriced := *position
+	unpriced.ID = uuid.NewString()
+	unpriced.Name = uuid.NewString()
+	err = testPNLListenersRepository.AddPositions(ctx, []*model.Position{&unpriced}, map[string]*model.Price{position.Name: price})
+	require.Error(t, err)
+}
+
+func TestPNLListenersRepository_AddPositions_Replace(t *testing.T) {
+	ctx := context.Background()
+	user := uuid.NewString()
+	position := &model.Position{ID: uuid.NewString(), User: user, Name: uuid.NewString(), Amount: 2, PurchasePrice: 100, Leverage: 2, Margin: 100}
+	prices := map[string]*model.Price{position.Name: {Name: position.Name, SellingPrice: 100}}
+
+	portfolios, unsubscribe := testPNLListenersRepository.SubscribePortfolio(user)
+	defer unsubscribe()
+
+	err := testPNLListenersRepository.AddPositions(ctx, []*model.Position{position}, prices)
+	require.NoError(t, err)
+	replayed := *position
+	replayed.Amount = 3
+	err = testPNLListenersRepository.AddPositions(ctx, []*model.Position{&replayed}, prices)
+	require.NoError(t, err)
+
+	testPNLListenersRepository.SendPricesPNL([]*model.Price{{Name: position.Name, SellingPrice: 110}})
+	var portfolio *model.Portfolio
+	require.Eventually(t, func() bool {
+		portfolio = <-portfolios
+		return len(portfolio.Positions) == 1 && portfolio.Positions[0].MarkPrice == 110
+	}, time.Second, time.Millisecond)
+	require.Equal(t, 3.0, portfolio.Positions[0].Position.Amount)
+
+	err = testPNLListenersRepository.RemovePosition(position)
+	require.NoError(t, err)
 }
 
 func TestPNLListenersRepository_Send_Close(t *testing.T) {
@@ -80,3 +114,230 @@ func TestPNLListenersRepository_Send_Close(t *testing.T) {
 	_, err = testPNLListenersRepository.ClosePosition(cancelContext)
 	require.Error(t, err)
 }
+
+func TestPNLListenersRepository_MaintenanceMargin(t *testing.T) {
+	ctx := context.Background()
+	position := &model.Position{
+		ID:                uuid.NewString(),
+		User:              uuid.NewString(),
+		Name:              uuid.NewString(),
+		Amount:            10,
+		PurchasePrice:     100,
+		Leverage:          10,
+		Margin:            100,
+		MaintenanceMargin: 50,
+	}
+	price := &model.Price{
+		Name:         position.Name,
+		SellingPrice: 100,
+	}
+
+	err := testPNLListenersRepository.AddPositions(ctx, []*model.Position{position}, map[string]*model.Price{position.Name: price})
+	require.NoError(t, err)
+
+	testPNLListenersRepository.SendPricesPNL([]*model.Price{{Name: position.Name, SellingPrice: 96}})
+	timeout, cancel := context.WithTimeout(ctx, time.Millisecond*100)
+	_, err = testPNLListenersRepository.ClosePosition(timeout)
+	cancel()
+	require.Error(t, err)
+
+	testPNLListenersRepository.SendPricesPNL([]*model.Price{{Name: position.Name, SellingPrice: 94}})
+	closed, err := testPNLListenersRepository.ClosePosition(ctx)
+	require.NoError(t, err)
+	require.Equal(t, position.ID, closed.ID)
+	require.Equal(t, model.LiquidationMaintenanceMargin, closed.LiquidationReason)
+}
+
+func TestPNLListenersRepository_Portfolio(t *testing.T) {
+	ctx := context.Background()
+	user := uuid.NewString()
+	positions := []*model.Position{
+		{ID: "worst", User: user, Name: uuid.NewString(), Amount: 10, PurchasePrice: 100, Leverage: 10, Margin: 100, MaintenanceMargin: 40},
+		{ID: "better", User: user, Name: uuid.NewString(), Amount: 5, PurchasePrice: 100, Leverage: 10, Margin: 50, MaintenanceMargin: 20},
+	}
+	prices := map[string]*model.Price{
+		positions[0].Name: {Name: positions[0].Name, SellingPrice: 100},
+		positions[1].Name: {Name: positions[1].Name, SellingPrice: 100},
+	}
+
+	err := testPNLListenersRepository.UpdateBalance(user, 100)
+	require.NoError(t, err)
+	err = testPNLListenersRepository.AddPositions(ctx, positions, prices)
+	require.NoError(t, err)
+
+	testPNLListenersRepository.SendPricesPNL([]*model.Price{
+		{Name: positions[0].Name, SellingPrice: 95},
+		{Name: positions[1].Name, SellingPrice: 95},
+	})
+	timeout, cancel := context.WithTimeout(ctx, time.Millisecond*100)
+	_, err = testPNLListenersRepository.ClosePosition(timeout)
+	cancel()
+	require.Error(t, err)
+
+	err = testPNLListenersRepository.UpdateBalance(user, -50)
+	require.NoError(t, err)
+	closed, err := testPNLListenersRepository.ClosePosition(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "worst", closed.ID)
+	require.Equal(t, model.LiquidationNegativePNL, closed.LiquidationReason)
+
+	timeout, cancel = context.WithTimeout(ctx, time.Millisecond*100)
+	_, err = testPNLListenersRepository.ClosePosition(timeout)
+	cancel()
+	require.Error(t, err)
+}
+
+func TestPNLListenersRepository_LiquidationPolicy(t *testing.T) {
+	small := &model.Position{ID: "small", Amount: 1, PurchasePrice: 100, Margin: 10}
+	large := &model.Position{ID: "large", Amount: 10, PurchasePrice: 100, Margin: 1000}
+	smallPrice := &model.Price{SellingPrice: 95}
+	largePrice := &model.Price{SellingPrice: 99}
+
+	require.True(t, liquidationPolicy(LiquidateWorstPNL)(large, small, largePrice, smallPrice))
+	require.True(t, liquidationPolicy(LiquidateWorstRatio)(small, large, smallPrice, largePrice))
+	require.True(t, liquidationPolicy(LiquidateLargest)(large, small, largePrice, smallPrice))
+	require.True(t, liquidationPolicy("unknown")(large, small, largePrice, smallPrice))
+}
+
+func TestPNLListenersRepository_LiquidatePortfolio(t *testing.T) {
+	// equity: loser 100, thin 2, large 400; maintenance margin 451.5 isn't covered by 502 of equity with -51 of balance,
+	// closing any single position covers maintenance margin of the rest
+	tests := []struct {
+		policy string
+		closed string
+	}{
+		{policy: LiquidateWorstPNL, closed: "loser"},
+		{policy: LiquidateWorstRatio, closed: "thin"},
+		{policy: LiquidateLargest, closed: "large"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.policy, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			repos := NewPNLListenersRepository(tt.policy)
+			user := uuid.NewString()
+			positions := []*model.Position{
+				{ID: "loser", User: user, Name: uuid.NewString(), Amount: 10, PurchasePrice: 100, Margin: 200, MaintenanceMargin: 100},
+				{ID: "thin", User: user, Name: uuid.NewString(), Amount: 1, PurchasePrice: 100, Margin: 10, MaintenanceMargin: 1.5},
+				{ID: "large", User: user, Name: uuid.NewString(), Amount: 20, PurchasePrice: 100, Margin: 400, MaintenanceMargin: 350},
+			}
+			prices := map[string]*model.Price{
+				positions[0].Name: {Name: positions[0].Name, SellingPrice: 90},
+				positions[1].Name: {Name: positions[1].Name, SellingPrice: 92},
+				positions[2].Name: {Name: positions[2].Name, SellingPrice: 100},
+			}
+
+			err := repos.UpdateBalance(user, -51)
+			require.NoError(t, err)
+			err = repos.AddPositions(ctx, positions, prices)
+			require.NoError(t, err)
+
+			closed, err := repos.ClosePosition(ctx)
+			require.NoError(t, err)
+			require.Equal(t, tt.closed, closed.ID)
+			require.Equal(t, model.LiquidationNegativePNL, closed.LiquidationReason)
+
+			timeout, cancelTimeout := context.WithTimeout(ctx, time.Millisecond*100)
+			_, err = repos.ClosePosition(timeout)
+			cancelTimeout()
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestPNLListenersRepository_SubscribePortfolio(t *testing.T) {
+	ctx := context.Background()
+	user := uuid.NewString()
+	position := &model.Position{ID: uuid.NewString(), User: user, Name: uuid.NewString(), Amount: 2, PurchasePrice: 100, Leverage: 2, Margin: 100}
+
+	portfolios, unsubscribe := testPNLListenersRepository.SubscribePortfolio(user)
+	defer unsubscribe()
+
+	err := testPNLListenersRepository.UpdateBalance(user, 50)
+	require.NoError(t, err)
+	err = testPNLListenersRepository.AddPositions(ctx, []*model.Position{position},
+		map[string]*model.Price{position.Name: {Name: position.Name, SellingPrice: 100}})
+	require.NoError(t, err)
+
+	testPNLListenersRepository.SendPricesPNL([]*model.Price{{Name: position.Name, SellingPrice: 110}})
+	var portfolio *model.Portfolio
+	require.Eventually(t, func() bool {
+		portfolio = <-portfolios
+		return len(portfolio.Positions) == 1 && portfolio.Positions[0].MarkPrice == 110
+	}, time.Second, time.Millisecond)
+	require.Equal(t, user, portfolio.User)
+	require.Equal(t, 20.0, portfolio.Positions[0].UnrealizedPNL)
+	require.Equal(t, 120.0, portfolio.Positions[0].Equity)
+	require.Equal(t, 170.0, portfolio.Equity)
+
+	again, unsubscribeAgain := testPNLListenersRepository.SubscribePortfolio(user)
+	portfolio = <-again
+	unsubscribeAgain()
+	require.Equal(t, 110.0, portfolio.Positions[0].MarkPrice)
+
+	err = testPNLListenersRepository.RemovePosition(position)
+	require.NoError(t, err)
+}
+
+func TestPNLListenersRepository_NotBlockedByLiquidation(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	repos := NewPNLListenersRepository(LiquidateWorstPNL)
+	user := uuid.NewString()
+	name := uuid.NewString()
+	positions := []*model.Position{
+		{ID: uuid.NewString(), User: user, Name: name, Amount: 1, PurchasePrice: 100, Margin: 10, MaintenanceMargin: 5},
+		{ID: uuid.NewString(), User: user, Name: name, Amount: 1, PurchasePrice: 100, Margin: 10, MaintenanceMargin: 5},
+	}
+
+	err := repos.AddPositions(ctx, positions, map[string]*model.Price{name: {Name: name, SellingPrice: 100}})
+	require.NoError(t, err)
+	repos.SendPricesPNL([]*model.Price{{Name: name, SellingPrice: 50}})
+	require.Eventually(t, func() bool { return len(repos.pnlDown) == 1 }, time.Second, time.Millisecond)
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		require.NoError(t, repos.UpdateBalance(user, 100))
+		require.NoError(t, repos.RemovePosition(positions[0]))
+		require.NoError(t, repos.UpdatePosition(positions[1]))
+		require.NoError(t, repos.AddPositions(ctx, positions[:1], map[string]*model.Price{name: {Name: name, SellingPrice: 50}}))
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("listener commands blocked by pending liquidation")
+	}
+
+	for range positions {
+		liquidated, closeErr := repos.ClosePosition(ctx)
+		require.NoError(t, closeErr)
+		require.Equal(t, model.LiquidationMaintenanceMargin, liquidated.LiquidationReason)
+	}
+}
+
+func TestPNLListenersRepository_SubscribePortfolio_Balance(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	repos := NewPNLListenersRepository(LiquidateWorstPNL)
+	user := uuid.NewString()
+
+	err := repos.UpdateBalance(user, 70)
+	require.NoError(t, err)
+	portfolios, unsubscribe := repos.SubscribePortfolio(user)
+	portfolio := <-portfolios
+	require.Equal(t, user, portfolio.User)
+	require.Empty(t, portfolio.Positions)
+	require.Equal(t, 70.0, portfolio.Balance)
+	require.Equal(t, 70.0, portfolio.Equity)
+
+	unsubscribe()
+	require.Empty(t, repos.feeds)
+
+	position := &model.Position{ID: uuid.NewString(), User: user, Name: uuid.NewString(), Amount: 1, PurchasePrice: 100, Margin: 100}
+	err = repos.AddPositions(ctx, []*model.Position{position}, map[string]*model.Price{position.Name: {Name: position.Name, SellingPrice: 100}})
+	require.NoError(t, err)
+	_, unsubscribe = repos.SubscribePortfolio(user)
+	unsubscribe()
+	require.Len(t, repos.feeds, 1)
+}
@@ -0,0 +1,56 @@
+// Package repository portfolio snapshots feed
+package repository
+
+import (
+	"sync"
+
+	"github.com/OVantsevich/Trading-Service/internal/model"
+)
+
+// portfolioFeed subscribers of portfolio snapshots of one user, slow subscriber gets only the latest snapshot
+type portfolioFeed struct {
+	mu          sync.Mutex
+	subscribers map[chan *model.Portfolio]struct{}
+}
+
+func newPortfolioFeed() *portfolioFeed {
+	return &portfolioFeed{subscribers: make(map[chan *model.Portfolio]struct{})}
+}
+
+// subscribe add subscriber which receives initial snapshot first if it is given, returned function removes it
+func (f *portfolioFeed) subscribe(initial *model.Portfolio) (<-chan *model.Portfolio, func()) {
+	c := make(chan *model.Portfolio, 1)
+	if initial != nil {
+		c <- initial
+	}
+	f.mu.Lock()
+	f.subscribers[c] = struct{}{}
+	f.mu.Unlock()
+	return c, func() {
+		f.mu.Lock()
+		delete(f.subscribers, c)
+		f.mu.Unlock()
+	}
+}
+
+// empty feed has no subscribers
+func (f *portfolioFeed) empty() bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.subscribers) == 0
+}
+
+// publish replace not yet received snapshot of every subscriber without blocking,
+// publisher is the only sender and sends under lock, so buffer is free after drain
+func (f *portfolioFeed) publish(portfolio *model.Portfolio) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for c := range f.subscribers {
+		select {
+		case <-c:
+			fanOutMetrics.Add("portfolio_coalesced", 1)
+		default:
+		}
+		c <- portfolio
+	}
+}
@@ -3,43 +3,170 @@ package repository
 
 import (
 	"context"
+	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"time"
 
 	"github.com/OVantsevich/Trading-Service/internal/model"
 
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
 )
 
+// listenReconnectBase delay before the first attempt to listen on a fresh connection
+const listenReconnectBase = 100 * time.Millisecond
+
+// listenReconnectMax max delay between attempts to listen on a fresh connection
+const listenReconnectMax = 10 * time.Second
+
+// positionTransitions statuses from which position is allowed to move to the status,
+// closing is reverted to the previous status only by RestoreStatus if close isn't committed
+var positionTransitions = map[string][]string{
+	model.PositionOpen:    {model.PositionPending},
+	model.PositionClosing: {model.PositionPending, model.PositionOpen},
+	model.PositionClosed:  {model.PositionPending, model.PositionOpen, model.PositionClosing},
+	model.PositionFailed:  {model.PositionPending, model.PositionOpen, model.PositionClosing},
+}
+
 // Position postgres entity
 type Position struct {
 	PgxWithinTransactionRunner
-	listenConn *pgx.Conn
+	listenConn *pgxpool.Conn
+	listenXmin string
+	missed     []*model.Notification
 }
 
 // NewPositionRepository creating new Position repository
 func NewPositionRepository(ctx context.Context, p PgxWithinTransactionRunner) (*Position, error) {
 	repos := &Position{PgxWithinTransactionRunner: p}
-	conn, err := repos.Pool().Acquire(ctx)
+	err := repos.listen(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("position - startListen - Acquire: %w", err)
+		return nil, fmt.Errorf("position - NewPositionRepository - listen: %w", err)
+	}
+
+	return repos, nil
+}
+
+// listen acquire connection and listen thresholds notifications on it,
+// remembers the oldest transaction which could commit after listening started as catch up watermark
+func (p *Position) listen(ctx context.Context) error {
+	conn, err := p.Pool().Acquire(ctx)
+	if err != nil {
+		return fmt.Errorf("position - listen - Acquire: %w", err)
 	}
 	_, err = conn.Exec(ctx, "listen thresholds")
 	if err != nil {
 		conn.Release()
-		return nil, fmt.Errorf("position - startListen - Exec: %w", err)
+		return fmt.Errorf("position - listen - Exec: %w", err)
+	}
+	var xmin string
+	err = conn.QueryRow(ctx, "select pg_snapshot_xmin(pg_current_snapshot())::text").Scan(&xmin)
+	if err != nil {
+		conn.Release()
+		return fmt.Errorf("position - listen - Scan: %w", err)
 	}
-	repos.listenConn = conn.Conn()
+	p.listenConn = conn
+	p.listenXmin = xmin
 
-	return repos, nil
+	return nil
+}
+
+// relisten replace broken listen connection with exponential backoff until success or context cancellation
+func (p *Position) relisten(ctx context.Context) error {
+	p.listenConn.Release()
+	delay := listenReconnectBase
+	for {
+		err := p.listen(ctx)
+		if err == nil {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("position - relisten: %w", err)
+		case <-time.After(delay):
+		}
+		delay *= 2
+		if delay > listenReconnectMax {
+			delay = listenReconnectMax
+		}
+	}
+}
+
+// catchUp build notifications for positions and orders changed by transactions not older than xmin,
+// every transaction committed after the watermark was taken is not older than it, so nothing is missed,
+// open position is reported as created and with its thresholds, closed position as closed
+func (p *Position) catchUp(ctx context.Context, xmin string) ([]*model.Notification, error) {
+	rows, err := p.Query(ctx, `select id, "user", "name", amount, stop_loss, take_profit, purchase_price, selling_price, short_position, updated, created, closed,
+									trailing_stop, trailing_percentage, trailing_level, leverage, margin, maintenance_margin, liquidation_reason, status,
+									close_reason, trigger_price, realized_pnl, fees, accrued_financing
+									from positions where change_xid >= $1::text::xid8 order by updated`, xmin)
+	if err != nil {
+		return nil, fmt.Errorf("position - catchUp - Query: %w", err)
+	}
+	defer rows.Close()
+
+	var result []*model.Notification
+	for rows.Next() {
+		pos := &model.Position{}
+		err = rows.Scan(&pos.ID, &pos.User, &pos.Name, &pos.Amount, &pos.StopLoss, &pos.TakeProfit, &pos.PurchasePrice, &pos.SellingPrice, &pos.ShortPosition, &pos.Updated, &pos.Created, &pos.Closed,
+			&pos.TrailingStop, &pos.TrailingPercentage, &pos.TrailingLevel, &pos.Leverage, &pos.Margin, &pos.MaintenanceMargin, &pos.LiquidationReason, &pos.Status,
+			&pos.CloseReason, &pos.TriggerPrice, &pos.RealizedPNL, &pos.Fees, &pos.AccruedFinancing)
+		if err != nil {
+			return nil, fmt.Errorf("position - catchUp - Scan: %w", err)
+		}
+		if pos.Closed != 0 {
+			result = append(result, &model.Notification{Position: pos, Type: "closed"})
+			continue
+		}
+		result = append(result, &model.Notification{Position: pos, Type: "created"}, &model.Notification{Position: pos, Type: "amount_changed"})
+		if pos.StopLoss > 0 {
+			result = append(result, &model.Notification{Position: pos, Type: "stop_loss"})
+		}
+		if pos.TakeProfit > 0 {
+			result = append(result, &model.Notification{Position: pos, Type: "take_profit"})
+		}
+		if pos.TrailingStop > 0 {
+			result = append(result, &model.Notification{Position: pos, Type: "trailing_stop"})
+		}
+	}
+	rows.Close()
+
+	rows, err = p.Query(ctx, `select id, "user", "name", amount, price, short_position, status, position_id, created, updated
+									from orders where change_xid >= $1::text::xid8 order by updated`, xmin)
+	if err != nil {
+		return nil, fmt.Errorf("position - catchUp - Query: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		order := &model.Order{}
+		err = rows.Scan(&order.ID, &order.User, &order.Name, &order.Amount, &order.Price, &order.ShortPosition, &order.Status, &order.PositionID, &order.Created, &order.Updated)
+		if err != nil {
+			return nil, fmt.Errorf("position - catchUp - Scan: %w", err)
+		}
+		if order.Status == model.OrderPending {
+			result = append(result, &model.Notification{Order: order, Type: "order_placed"})
+			continue
+		}
+		result = append(result, &model.Notification{Order: order, Type: "order_closed"})
+	}
+
+	return result, nil
 }
 
 // CreatePosition create position
 func (p *Position) CreatePosition(ctx context.Context, position *model.Position) (*model.Position, error) {
+	if position.Status == "" {
+		position.Status = model.PositionPending
+	}
 	row := p.QueryRow(ctx,
-		`insert into positions (id, "user", "name", amount, created, purchase_price, short_position, updated) values ($1, $2, $3, $4, $5, $6, $7, $8) returning id;`,
-		position.ID, position.User, position.Name, position.Amount, position.Created, position.PurchasePrice, position.ShortPosition, position.Updated)
+		`insert into positions (id, "user", "name", amount, created, purchase_price, short_position, updated, leverage, margin, maintenance_margin, status,
+			realized_pnl, fees)
+			values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14) returning id;`,
+		position.ID, position.User, position.Name, position.Amount, position.Created, position.PurchasePrice, position.ShortPosition, position.Updated,
+		position.Leverage, position.Margin, position.MaintenanceMargin, position.Status, position.RealizedPNL, position.Fees)
 	err := row.Scan(&position.ID)
 	if err != nil {
 		return nil, fmt.Errorf("position - CreatePosition - Scan: %w", err)
@@ -51,9 +178,13 @@ func (p *Position) CreatePosition(ctx context.Context, position *model.Position)
 // GetPositionByID get Position by id
 func (p *Position) GetPositionByID(ctx context.Context, positionID string) (*model.Position, error) {
 	pos := &model.Position{}
-	row := p.QueryRow(ctx, `select id, "user", "name", amount, stop_loss, take_profit, purchase_price, selling_price, short_position, updated, created, closed
+	row := p.QueryRow(ctx, `select id, "user", "name", amount, stop_loss, take_profit, purchase_price, selling_price, short_position, updated, created, closed,
+									trailing_stop, trailing_percentage, trailing_level, leverage, margin, maintenance_margin, liquidation_reason, status,
+									close_reason, trigger_price, realized_pnl, fees, accrued_financing
 									from positions where id = $1`, positionID)
-	err := row.Scan(&pos.ID, &pos.User, &pos.Name, &pos.Amount, &pos.StopLoss, &pos.TakeProfit, &pos.PurchasePrice, &pos.SellingPrice, &pos.ShortPosition, &pos.Updated, &pos.Created, &pos.Closed)
+	err := row.Scan(&pos.ID, &pos.User, &pos.Name, &pos.Amount, &pos.StopLoss, &pos.TakeProfit, &pos.PurchasePrice, &pos.SellingPrice, &pos.ShortPosition, &pos.Updated, &pos.Created, &pos.Closed,
+		&pos.TrailingStop, &pos.TrailingPercentage, &pos.TrailingLevel, &pos.Leverage, &pos.Margin, &pos.MaintenanceMargin, &pos.LiquidationReason, &pos.Status,
+		&pos.CloseReason, &pos.TriggerPrice, &pos.RealizedPNL, &pos.Fees, &pos.AccruedFinancing)
 	if err != nil {
 		return nil, fmt.Errorf("position - GetPositionByID - Scan: %w", err)
 	}
@@ -63,7 +194,9 @@ func (p *Position) GetPositionByID(ctx context.Context, positionID string) (*mod
 
 // GetUserPositions get positions by user id
 func (p *Position) GetUserPositions(ctx context.Context, userID string) ([]*model.Position, error) {
-	rows, err := p.Query(ctx, `select id, "name", amount, stop_loss, take_profit, purchase_price, selling_price, short_position, updated, created, closed
+	rows, err := p.Query(ctx, `select id, "name", amount, stop_loss, take_profit, purchase_price, selling_price, short_position, updated, created, closed,
+									trailing_stop, trailing_percentage, trailing_level, leverage, margin, maintenance_margin, liquidation_reason, status,
+									close_reason, trigger_price, realized_pnl, fees, accrued_financing
 									from positions where "user" = $1`, userID)
 	if err != nil {
 		return nil, fmt.Errorf("position - GetUserPositions - Query: %w", err)
@@ -74,7 +207,9 @@ func (p *Position) GetUserPositions(ctx context.Context, userID string) ([]*mode
 		pos := &model.Position{
 			User: userID,
 		}
-		err = rows.Scan(&pos.ID, &pos.Name, &pos.Amount, &pos.StopLoss, &pos.TakeProfit, &pos.PurchasePrice, &pos.SellingPrice, &pos.ShortPosition, &pos.Updated, &pos.Created, &pos.Closed)
+		err = rows.Scan(&pos.ID, &pos.Name, &pos.Amount, &pos.StopLoss, &pos.TakeProfit, &pos.PurchasePrice, &pos.SellingPrice, &pos.ShortPosition, &pos.Updated, &pos.Created, &pos.Closed,
+			&pos.TrailingStop, &pos.TrailingPercentage, &pos.TrailingLevel, &pos.Leverage, &pos.Margin, &pos.MaintenanceMargin, &pos.LiquidationReason, &pos.Status,
+			&pos.CloseReason, &pos.TriggerPrice, &pos.RealizedPNL, &pos.Fees, &pos.AccruedFinancing)
 		if err != nil {
 			return nil, fmt.Errorf("position - GetUserPositions - Scan: %w", err)
 		}
@@ -84,13 +219,152 @@ func (p *Position) GetUserPositions(ctx context.Context, userID string) ([]*mode
 	return result, nil
 }
 
-// UpdatePosition update position excluding thresholds
+// GetOpenPositions get all positions which aren't closed
+func (p *Position) GetOpenPositions(ctx context.Context) ([]*model.Position, error) {
+	rows, err := p.Query(ctx, `select id, "user", "name", amount, stop_loss, take_profit, purchase_price, selling_price, short_position, updated, created, closed,
+									trailing_stop, trailing_percentage, trailing_level, leverage, margin, maintenance_margin, liquidation_reason, status,
+									close_reason, trigger_price, realized_pnl, fees, accrued_financing
+									from positions where closed = 0`)
+	if err != nil {
+		return nil, fmt.Errorf("position - GetOpenPositions - Query: %w", err)
+	}
+	defer rows.Close()
+
+	var result []*model.Position
+	for rows.Next() {
+		pos := &model.Position{}
+		err = rows.Scan(&pos.ID, &pos.User, &pos.Name, &pos.Amount, &pos.StopLoss, &pos.TakeProfit, &pos.PurchasePrice, &pos.SellingPrice, &pos.ShortPosition, &pos.Updated, &pos.Created, &pos.Closed,
+			&pos.TrailingStop, &pos.TrailingPercentage, &pos.TrailingLevel, &pos.Leverage, &pos.Margin, &pos.MaintenanceMargin, &pos.LiquidationReason, &pos.Status,
+			&pos.CloseReason, &pos.TriggerPrice, &pos.RealizedPNL, &pos.Fees, &pos.AccruedFinancing)
+		if err != nil {
+			return nil, fmt.Errorf("position - GetOpenPositions - Scan: %w", err)
+		}
+		result = append(result, pos)
+	}
+
+	return result, nil
+}
+
+// positionCursor position after which next page starts
+type positionCursor struct {
+	Created time.Time `json:"created"`
+	ID      string    `json:"id"`
+}
+
+// ListPositions get page of user positions ordered by creation time, returns cursor of the next page or empty string for the last page
+func (p *Position) ListPositions(ctx context.Context, filter *model.PositionFilter) ([]*model.Position, string, error) {
+	args := []any{filter.User}
+	arg := func(v any) string {
+		args = append(args, v)
+		return fmt.Sprintf("$%d", len(args))
+	}
+	query := `select id, "user", "name", amount, stop_loss, take_profit, purchase_price, selling_price, short_position, updated, created, closed,
+									trailing_stop, trailing_percentage, trailing_level, leverage, margin, maintenance_margin, liquidation_reason, status,
+									close_reason, trigger_price, realized_pnl, fees, accrued_financing
+									from positions where "user" = $1`
+	if filter.Open != nil {
+		if *filter.Open {
+			query += " and closed = 0"
+		} else {
+			query += " and closed != 0"
+		}
+	}
+	if filter.Name != "" {
+		query += ` and "name" = ` + arg(filter.Name)
+	}
+	if !filter.CreatedFrom.IsZero() {
+		query += " and created >= " + arg(filter.CreatedFrom)
+	}
+	if !filter.CreatedTo.IsZero() {
+		query += " and created < " + arg(filter.CreatedTo)
+	}
+	if filter.ClosedFrom != 0 {
+		query += " and closed >= " + arg(filter.ClosedFrom)
+	}
+	if filter.ClosedTo != 0 {
+		query += " and closed != 0 and closed < " + arg(filter.ClosedTo)
+	}
+	if filter.Short != nil {
+		query += " and short_position = " + arg(*filter.Short)
+	}
+	order, after := "asc", ">"
+	if filter.Descending {
+		order, after = "desc", "<"
+	}
+	if filter.Cursor != "" {
+		cursor, err := decodeCursor(filter.Cursor)
+		if err != nil {
+			return nil, "", fmt.Errorf("position - ListPositions - decodeCursor: %w", err)
+		}
+		query += fmt.Sprintf(" and (created, id) %s (%s, %s)", after, arg(cursor.Created), arg(cursor.ID))
+	}
+	query += fmt.Sprintf(" order by created %s, id %s limit %s", order, order, arg(filter.Limit+1))
+
+	rows, err := p.Query(ctx, query, args...)
+	if err != nil {
+		return nil, "", fmt.Errorf("position - ListPositions - Query: %w", err)
+	}
+	defer rows.Close()
+
+	var result []*model.Position
+	for rows.Next() {
+		pos := &model.Position{}
+		err = rows.Scan(&pos.ID, &pos.User, &pos.Name, &pos.Amount, &pos.StopLoss, &pos.TakeProfit, &pos.PurchasePrice, &pos.SellingPrice, &pos.ShortPosition, &pos.Updated, &pos.Created, &pos.Closed,
+			&pos.TrailingStop, &pos.TrailingPercentage, &pos.TrailingLevel, &pos.Leverage, &pos.Margin, &pos.MaintenanceMargin, &pos.LiquidationReason, &pos.Status,
+			&pos.CloseReason, &pos.TriggerPrice, &pos.RealizedPNL, &pos.Fees, &pos.AccruedFinancing)
+		if err != nil {
+			return nil, "", fmt.Errorf("position - ListPositions - Scan: %w", err)
+		}
+		result = append(result, pos)
+	}
+	if len(result) <= filter.Limit {
+		return result, "", nil
+	}
+
+	result = result[:filter.Limit]
+	last := result[len(result)-1]
+	next, err := encodeCursor(&positionCursor{Created: last.Created, ID: last.ID})
+	if err != nil {
+		return nil, "", fmt.Errorf("position - ListPositions - encodeCursor: %w", err)
+	}
+	return result, next, nil
+}
+
+// encodeCursor opaque cursor of page
+func encodeCursor(cursor *positionCursor) (string, error) {
+	data, err := json.Marshal(cursor)
+	if err != nil {
+		return "", fmt.Errorf("position - encodeCursor - Marshal: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+// decodeCursor parse cursor returned with previous page
+func decodeCursor(s string) (*positionCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, model.ErrInvalidCursor
+	}
+	cursor := &positionCursor{}
+	err = json.Unmarshal(data, cursor)
+	if err != nil || cursor.ID == "" {
+		return nil, model.ErrInvalidCursor
+	}
+	return cursor, nil
+}
+
+// UpdatePosition update position excluding thresholds, position which is being closed or closed isn't changed
 func (p *Position) UpdatePosition(ctx context.Context, position *model.Position) error {
-	_, err := p.Exec(ctx, `update positions set amount=$1, updated=$2 where id=$3 and closed = 0;`,
-		position.Amount, position.Updated, position.ID)
+	tag, err := p.Exec(ctx, `update positions set amount=$1, purchase_price=$2, margin=$3, maintenance_margin=$4, realized_pnl=$5, fees=$6, updated=$7
+			where id=$8 and closed = 0 and status = any($9);`,
+		position.Amount, position.PurchasePrice, position.Margin, position.MaintenanceMargin, position.RealizedPNL, position.Fees, position.Updated, position.ID,
+		[]string{model.PositionPending, model.PositionOpen})
 	if err != nil {
 		return fmt.Errorf("position - UpdatePosition - Exec: %w", err)
 	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("position - UpdatePosition: %w", p.transitionError(ctx, position.ID))
+	}
 
 	return nil
 }
@@ -117,12 +391,154 @@ func (p *Position) SetTakeProfit(ctx context.Context, id string, takeProfit floa
 	return nil
 }
 
-// ClosePosition close position
-func (p *Position) ClosePosition(ctx context.Context, id string, closed int64, sellingPrice float64, updated time.Time) (*model.Position, error) {
+// SetTrailingStop set trailing stop distance, resets ratcheted level
+func (p *Position) SetTrailingStop(ctx context.Context, id string, trailingStop float64, percentage bool, updated time.Time) error {
+	_, err := p.Exec(ctx, `update positions set trailing_stop=$1, trailing_percentage=$2, trailing_level=0, updated=$3 where id=$4 and closed = 0;`,
+		trailingStop, percentage, updated, id)
+	if err != nil {
+		return fmt.Errorf("position - SetTrailingStop - Exec: %w", err)
+	}
+
+	return nil
+}
+
+// SetTrailingLevel persist ratcheted trailing stop level
+func (p *Position) SetTrailingLevel(ctx context.Context, id string, level float64, updated time.Time) error {
+	_, err := p.Exec(ctx, `update positions set trailing_level=$1, updated=$2 where id=$3 and closed = 0;`,
+		level, updated, id)
+	if err != nil {
+		return fmt.Errorf("position - SetTrailingLevel - Exec: %w", err)
+	}
+
+	return nil
+}
+
+// SetLiquidationReason set reason of forced close
+func (p *Position) SetLiquidationReason(ctx context.Context, id, reason string, updated time.Time) error {
+	_, err := p.Exec(ctx, `update positions set liquidation_reason=$1, updated=$2 where id=$3 and closed = 0;`,
+		reason, updated, id)
+	if err != nil {
+		return fmt.Errorf("position - SetLiquidationReason - Exec: %w", err)
+	}
+
+	return nil
+}
+
+// AddFinancing charge financing from open position, it is accrued and taken from realized pnl
+func (p *Position) AddFinancing(ctx context.Context, id string, amount float64, updated time.Time) error {
+	tag, err := p.Exec(ctx, `update positions set accrued_financing=accrued_financing+$1, realized_pnl=realized_pnl-$1, updated=$2
+				where id=$3 and closed = 0 and status = $4;`,
+		amount, updated, id, model.PositionOpen)
+	if err != nil {
+		return fmt.Errorf("position - AddFinancing - Exec: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("position - AddFinancing: %w", p.transitionError(ctx, id))
+	}
+
+	return nil
+}
+
+// SetStatus move position to the status if its current status allows it, returns previous status
+func (p *Position) SetStatus(ctx context.Context, id, status string, updated time.Time) (string, error) {
+	from, ok := positionTransitions[status]
+	if !ok {
+		return "", fmt.Errorf("position - SetStatus: unknown status %s", status)
+	}
+	var previous string
+	row := p.QueryRow(ctx, `with old as (select id, status from positions where id = $1 for update)
+				update positions set status=$2, previous_status=old.status, updated=$3 from old where positions.id = old.id and old.status = any($4)
+				returning old.status;`,
+		id, status, updated, from)
+	err := row.Scan(&previous)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return "", fmt.Errorf("position - SetStatus: %w", p.transitionError(ctx, id))
+	}
+	if err != nil {
+		return "", fmt.Errorf("position - SetStatus - Scan: %w", err)
+	}
+
+	return previous, nil
+}
+
+// ConfirmPosition open pending position which initial margin is debited,
+// position which is being closed is opened only if its close isn't committed
+func (p *Position) ConfirmPosition(ctx context.Context, id string, updated time.Time) error {
+	tag, err := p.Exec(ctx, `update positions set status = case when status = $2 then $3 else status end,
+				previous_status = case when status = $4 then $3 else previous_status end, updated=$5
+				where id=$1 and closed = 0 and (status = $2 or (status = $4 and previous_status = $2));`,
+		id, model.PositionPending, model.PositionOpen, model.PositionClosing, updated)
+	if err != nil {
+		return fmt.Errorf("position - ConfirmPosition - Exec: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("position - ConfirmPosition: %w", p.transitionError(ctx, id))
+	}
+
+	return nil
+}
+
+// RestoreStatus move position which close isn't committed back to the status it had before closing
+func (p *Position) RestoreStatus(ctx context.Context, id string, updated time.Time) error {
+	tag, err := p.Exec(ctx, `update positions set status=previous_status, updated=$2 where id=$1 and status = $3 and closed = 0;`,
+		id, updated, model.PositionClosing)
+	if err != nil {
+		return fmt.Errorf("position - RestoreStatus - Exec: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("position - RestoreStatus: %w", p.transitionError(ctx, id))
+	}
+
+	return nil
+}
+
+// RestoreClosing move positions which close was interrupted back to the status they had before closing
+func (p *Position) RestoreClosing(ctx context.Context, updated time.Time) error {
+	_, err := p.Exec(ctx, `update positions set status=previous_status, updated=$1 where status = $2 and closed = 0;`,
+		updated, model.PositionClosing)
+	if err != nil {
+		return fmt.Errorf("position - RestoreClosing - Exec: %w", err)
+	}
+
+	return nil
+}
+
+// transitionError explain why position with given id can't change its status
+func (p *Position) transitionError(ctx context.Context, id string) error {
+	var status string
+	err := p.QueryRow(ctx, `select status from positions where id = $1`, id).Scan(&status)
+	if err != nil {
+		return fmt.Errorf("position - transitionError - Scan: %w", err)
+	}
+	switch status {
+	case model.PositionClosing:
+		return model.ErrPositionClosing
+	case model.PositionClosed, model.PositionFailed:
+		return model.ErrPositionClosed
+	default:
+		return model.ErrPositionTransition
+	}
+}
+
+// ClosePosition close position with closed or failed status recording close reason, trigger price, pnl realized by close and its fee,
+// realized pnl and fees are added to those of previous trades of position
+func (p *Position) ClosePosition(ctx context.Context, position *model.Position) (*model.Position, error) {
+	if position.Status != model.PositionClosed && position.Status != model.PositionFailed {
+		return nil, fmt.Errorf("position - ClosePosition: position can't be closed with status %s", position.Status)
+	}
 	pos := &model.Position{}
-	row := p.QueryRow(ctx, `update positions set closed=$1, updated=$2, selling_price=$3 where id=$4 and closed = 0 returning amount, "name", "user", purchase_price, short_position;`,
-		closed, updated, sellingPrice, id)
-	err := row.Scan(&pos.Amount, &pos.Name, &pos.User, &pos.PurchasePrice, &pos.ShortPosition)
+	row := p.QueryRow(ctx, `update positions set closed=$1, updated=$2, selling_price=$3, status=$4, close_reason=$5, trigger_price=$6,
+				 realized_pnl=realized_pnl+$7, fees=fees+$8
+				 where id=$9 and closed = 0 and status = any($10)
+				 returning amount, "name", "user", purchase_price, short_position, leverage, margin, maintenance_margin, status, close_reason, trigger_price,
+				 realized_pnl, fees, accrued_financing;`,
+		position.Closed, position.Updated, position.SellingPrice, position.Status, position.CloseReason, position.TriggerPrice, position.RealizedPNL, position.Fees,
+		position.ID, positionTransitions[position.Status])
+	err := row.Scan(&pos.Amount, &pos.Name, &pos.User, &pos.PurchasePrice, &pos.ShortPosition, &pos.Leverage, &pos.Margin, &pos.MaintenanceMargin, &pos.Status,
+		&pos.CloseReason, &pos.TriggerPrice, &pos.RealizedPNL, &pos.Fees, &pos.AccruedFinancing)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, fmt.Errorf("position - ClosePosition: %w", p.transitionError(ctx, position.ID))
+	}
 	if err != nil {
 		return nil, fmt.Errorf("position - ClosePosition - Exec: %w", err)
 	}
@@ -130,18 +546,54 @@ func (p *Position) ClosePosition(ctx context.Context, id string, closed int64, s
 	return pos, nil
 }
 
-// GetNotification get notification from listen/notify
-func (p *Position) GetNotification(ctx context.Context) (*model.Notification, error) {
-	msg, err := p.listenConn.WaitForNotification(ctx)
+// CreateFill record realized part of position
+func (p *Position) CreateFill(ctx context.Context, fill *model.Fill) error {
+	_, err := p.Exec(ctx, `insert into position_fills (id, position_id, amount, price, created) values ($1, $2, $3, $4, $5);`,
+		fill.ID, fill.PositionID, fill.Amount, fill.Price, fill.Created)
 	if err != nil {
-		return nil, fmt.Errorf("position - GetNotification - WaitForNotification: %w", err)
+		return fmt.Errorf("position - CreateFill - Exec: %w", err)
 	}
 
-	notify := &model.Notification{}
-	err = json.Unmarshal([]byte(msg.Payload), &notify)
-	if err != nil {
-		return nil, fmt.Errorf("positions - GetNotification - Unmarshal: %w", err)
+	return nil
+}
+
+// AckNotification advance catch up watermark after notification is dispatched,
+// transactions committed later are not older than the oldest one running when notification was sent
+func (p *Position) AckNotification(notify *model.Notification) {
+	if notify.Xmin != "" {
+		p.listenXmin = notify.Xmin
+	}
+}
+
+// GetNotification get notification from listen/notify, after listen connection is lost
+// it is restored and notifications missed since the last acknowledged one are reported first
+func (p *Position) GetNotification(ctx context.Context) (*model.Notification, error) {
+	for len(p.missed) == 0 {
+		msg, err := p.listenConn.Conn().WaitForNotification(ctx)
+		if err == nil {
+			notify := &model.Notification{}
+			err = json.Unmarshal([]byte(msg.Payload), &notify)
+			if err != nil {
+				return nil, fmt.Errorf("positions - GetNotification - Unmarshal: %w", err)
+			}
+			return notify, nil
+		}
+		if ctx.Err() != nil {
+			return nil, fmt.Errorf("position - GetNotification - WaitForNotification: %w", err)
+		}
+
+		xmin := p.listenXmin
+		err = p.relisten(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("position - GetNotification - relisten: %w", err)
+		}
+		p.missed, err = p.catchUp(ctx, xmin)
+		if err != nil {
+			return nil, fmt.Errorf("position - GetNotification - catchUp: %w", err)
+		}
 	}
 
+	notify := p.missed[0]
+	p.missed = p.missed[1:]
 	return notify, nil
 }
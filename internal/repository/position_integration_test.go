@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"fmt"
 	"math/rand"
 	"testing"
 	"time"
@@ -41,14 +42,14 @@ func TestPosition_Create_Close_Position(t *testing.T) {
 		require.Error(t, err)
 
 		var closed *model.Position
-		closed, err = testPositionRepository.ClosePosition(ctx, p.ID, time.Now().Unix(), 0.0, time.Now())
+		closed, err = testPositionRepository.ClosePosition(ctx, &model.Position{ID: p.ID, Status: model.PositionClosed, Closed: time.Now().Unix(), SellingPrice: 0.0, CloseReason: model.CloseManual, Updated: time.Now()})
 		require.NoError(t, err)
 		require.Equal(t, closed.Amount, p.Amount)
 
 		_, err = testPositionRepository.GetNotification(ctx)
 		require.NoError(t, err)
 
-		_, err = testPositionRepository.ClosePosition(ctx, p.ID, time.Now().Unix(), 0.0, time.Now())
+		_, err = testPositionRepository.ClosePosition(ctx, &model.Position{ID: p.ID, Status: model.PositionClosed, Closed: time.Now().Unix(), SellingPrice: 0.0, CloseReason: model.CloseManual, Updated: time.Now()})
 		require.Error(t, err)
 
 		p.ID = uuid.New().String()
@@ -57,7 +58,7 @@ func TestPosition_Create_Close_Position(t *testing.T) {
 		_, err = testPositionRepository.GetNotification(ctx)
 		require.NoError(t, err)
 
-		_, err = testPositionRepository.ClosePosition(ctx, p.ID, time.Now().Add(time.Second).Unix(), 0.0, time.Now())
+		_, err = testPositionRepository.ClosePosition(ctx, &model.Position{ID: p.ID, Status: model.PositionClosed, Closed: time.Now().Add(time.Second).Unix(), SellingPrice: 0.0, CloseReason: model.CloseManual, Updated: time.Now()})
 		require.NoError(t, err)
 
 		_, err = testPositionRepository.GetNotification(ctx)
@@ -96,7 +97,7 @@ func TestPosition_GetPositionByID(t *testing.T) {
 		_, err = testPositionRepository.GetPositionByID(ctx, wrongPos.ID)
 		require.Error(t, err)
 
-		_, err = testPositionRepository.ClosePosition(ctx, p.ID, time.Now().Add(time.Second).Unix(), 0.0, time.Now())
+		_, err = testPositionRepository.ClosePosition(ctx, &model.Position{ID: p.ID, Status: model.PositionClosed, Closed: time.Now().Add(time.Second).Unix(), SellingPrice: 0.0, CloseReason: model.CloseManual, Updated: time.Now()})
 		require.NoError(t, err)
 
 		_, err = testPositionRepository.GetNotification(ctx)
@@ -131,7 +132,7 @@ func TestPosition_GetUserPositions(t *testing.T) {
 	require.Equal(t, len(testData), len(getById))
 
 	for _, p := range testData {
-		_, err = testPositionRepository.ClosePosition(ctx, p.ID, time.Now().Unix(), 0.0, time.Now())
+		_, err = testPositionRepository.ClosePosition(ctx, &model.Position{ID: p.ID, Status: model.PositionClosed, Closed: time.Now().Unix(), SellingPrice: 0.0, CloseReason: model.CloseManual, Updated: time.Now()})
 		require.NoError(t, err)
 
 		_, err = testPositionRepository.GetNotification(ctx)
@@ -171,7 +172,7 @@ func TestPosition_SL_TP_GetPosition(t *testing.T) {
 		require.Error(t, err)
 		require.Equal(t, (*model.Notification)(nil), res)
 
-		_, err = testPositionRepository.ClosePosition(ctx, p.ID, time.Now().Unix(), 0.0, time.Now())
+		_, err = testPositionRepository.ClosePosition(ctx, &model.Position{ID: p.ID, Status: model.PositionClosed, Closed: time.Now().Unix(), SellingPrice: 0.0, CloseReason: model.CloseManual, Updated: time.Now()})
 		require.NoError(t, err)
 		res, err = testPositionRepository.GetNotification(ctx)
 		require.NoError(t, err)
@@ -186,3 +187,369 @@ func TestPosition_SL_TP_GetPosition(t *testing.T) {
 	}
 
 }
+
+func TestPosition_UpdatePosition_CreateFill(t *testing.T) {
+	ctx := context.Background()
+	p := &model.Position{
+		ID:      uuid.NewString(),
+		User:    uuid.NewString(),
+		Name:    "name",
+		Amount:  100,
+		Created: time.Now(),
+		Updated: time.Now(),
+	}
+
+	_, err := testPositionRepository.CreatePosition(ctx, p)
+	require.NoError(t, err)
+	_, err = testPositionRepository.GetNotification(ctx)
+	require.NoError(t, err)
+
+	p.Amount = 40
+	p.PurchasePrice = 12.5
+	err = testPositionRepository.UpdatePosition(ctx, p)
+	require.NoError(t, err)
+	notify, err := testPositionRepository.GetNotification(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "amount_changed", notify.Type)
+	require.Equal(t, p.Amount, notify.Amount)
+
+	err = testPositionRepository.CreateFill(ctx, &model.Fill{
+		ID:         uuid.NewString(),
+		PositionID: p.ID,
+		Amount:     60,
+		Price:      10,
+		Created:    time.Now(),
+	})
+	require.NoError(t, err)
+
+	err = testPositionRepository.CreateFill(ctx, &model.Fill{
+		ID:         uuid.NewString(),
+		PositionID: "wrongID",
+		Amount:     60,
+		Price:      10,
+		Created:    time.Now(),
+	})
+	require.Error(t, err)
+
+	pos, err := testPositionRepository.GetPositionByID(ctx, p.ID)
+	require.NoError(t, err)
+	require.Equal(t, p.Amount, pos.Amount)
+	require.Equal(t, p.PurchasePrice, pos.PurchasePrice)
+}
+
+func TestPosition_GetNotification_Relisten(t *testing.T) {
+	ctx := context.Background()
+	p := &model.Position{
+		ID:      uuid.NewString(),
+		User:    uuid.NewString(),
+		Name:    "name",
+		Amount:  100,
+		Created: time.Now(),
+		Updated: time.Now(),
+	}
+
+	_, err := testPositionRepository.Exec(ctx, `select pg_terminate_backend($1)`, testPositionRepository.listenConn.Conn().PgConn().PID())
+	require.NoError(t, err)
+
+	_, err = testPositionRepository.CreatePosition(ctx, p)
+	require.NoError(t, err)
+	err = testPositionRepository.SetStopLoss(ctx, p.ID, 10, time.Now())
+	require.NoError(t, err)
+
+	types := make(map[string]bool)
+	for len(testPositionRepository.missed) > 0 || len(types) == 0 {
+		notify, err := testPositionRepository.GetNotification(ctx)
+		require.NoError(t, err)
+		if notify.Position != nil && notify.ID == p.ID {
+			types[notify.Type] = true
+		}
+	}
+	require.True(t, types["created"])
+	require.True(t, types["stop_loss"])
+
+	_, err = testPositionRepository.ClosePosition(ctx, &model.Position{ID: p.ID, Status: model.PositionClosed, Closed: time.Now().Unix(), SellingPrice: 0.0, CloseReason: model.CloseManual, Updated: time.Now()})
+	require.NoError(t, err)
+	notify, err := testPositionRepository.GetNotification(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "closed", notify.Type)
+	require.Equal(t, p.ID, notify.ID)
+}
+
+func TestPosition_SetStatus(t *testing.T) {
+	ctx := context.Background()
+	p := &model.Position{
+		ID:      uuid.NewString(),
+		User:    uuid.NewString(),
+		Name:    "status",
+		Amount:  1,
+		Created: time.Now(),
+		Updated: time.Now(),
+	}
+	_, err := testPositionRepository.CreatePosition(ctx, p)
+	require.NoError(t, err)
+	_, err = testPositionRepository.GetNotification(ctx)
+	require.NoError(t, err)
+
+	previous, err := testPositionRepository.SetStatus(ctx, p.ID, model.PositionOpen, time.Now())
+	require.NoError(t, err)
+	require.Equal(t, model.PositionPending, previous)
+
+	previous, err = testPositionRepository.SetStatus(ctx, p.ID, model.PositionClosing, time.Now())
+	require.NoError(t, err)
+	require.Equal(t, model.PositionOpen, previous)
+
+	_, err = testPositionRepository.SetStatus(ctx, p.ID, model.PositionClosing, time.Now())
+	require.ErrorIs(t, err, model.ErrPositionClosing)
+
+	closed, err := testPositionRepository.ClosePosition(ctx, &model.Position{ID: p.ID, Status: model.PositionClosed, Closed: time.Now().Unix(), SellingPrice: 0.0, CloseReason: model.CloseManual, Updated: time.Now()})
+	require.NoError(t, err)
+	require.Equal(t, model.PositionClosed, closed.Status)
+	_, err = testPositionRepository.GetNotification(ctx)
+	require.NoError(t, err)
+
+	_, err = testPositionRepository.SetStatus(ctx, p.ID, model.PositionOpen, time.Now())
+	require.ErrorIs(t, err, model.ErrPositionClosed)
+	_, err = testPositionRepository.ClosePosition(ctx, &model.Position{ID: p.ID, Status: model.PositionClosed, Closed: time.Now().Unix(), SellingPrice: 0.0, CloseReason: model.CloseManual, Updated: time.Now()})
+	require.ErrorIs(t, err, model.ErrPositionClosed)
+
+	pos, err := testPositionRepository.GetPositionByID(ctx, p.ID)
+	require.NoError(t, err)
+	require.Equal(t, model.PositionClosed, pos.Status)
+}
+
+func TestPosition_UpdatePosition_Closing(t *testing.T) {
+	ctx := context.Background()
+	p := &model.Position{
+		ID:      uuid.NewString(),
+		User:    uuid.NewString(),
+		Name:    "closing",
+		Amount:  10,
+		Created: time.Now(),
+		Updated: time.Now(),
+	}
+	_, err := testPositionRepository.CreatePosition(ctx, p)
+	require.NoError(t, err)
+	_, err = testPositionRepository.GetNotification(ctx)
+	require.NoError(t, err)
+
+	_, err = testPositionRepository.SetStatus(ctx, p.ID, model.PositionClosing, time.Now())
+	require.NoError(t, err)
+	p.Amount = 20
+	err = testPositionRepository.UpdatePosition(ctx, p)
+	require.ErrorIs(t, err, model.ErrPositionClosing)
+
+	err = testPositionRepository.RestoreClosing(ctx, time.Now())
+	require.NoError(t, err)
+	pos, err := testPositionRepository.GetPositionByID(ctx, p.ID)
+	require.NoError(t, err)
+	require.Equal(t, model.PositionPending, pos.Status)
+	require.Equal(t, 10.0, pos.Amount)
+
+	_, err = testPositionRepository.SetStatus(ctx, p.ID, model.PositionOpen, time.Now())
+	require.NoError(t, err)
+	_, err = testPositionRepository.SetStatus(ctx, p.ID, model.PositionClosing, time.Now())
+	require.NoError(t, err)
+	err = testPositionRepository.RestoreClosing(ctx, time.Now())
+	require.NoError(t, err)
+	pos, err = testPositionRepository.GetPositionByID(ctx, p.ID)
+	require.NoError(t, err)
+	require.Equal(t, model.PositionOpen, pos.Status)
+
+	err = testPositionRepository.UpdatePosition(ctx, p)
+	require.NoError(t, err)
+	_, err = testPositionRepository.GetNotification(ctx)
+	require.NoError(t, err)
+
+	_, err = testPositionRepository.ClosePosition(ctx, &model.Position{ID: p.ID, Status: model.PositionClosed, Closed: time.Now().Unix(), CloseReason: model.CloseManual, Updated: time.Now()})
+	require.NoError(t, err)
+	_, err = testPositionRepository.GetNotification(ctx)
+	require.NoError(t, err)
+	err = testPositionRepository.UpdatePosition(ctx, p)
+	require.ErrorIs(t, err, model.ErrPositionClosed)
+}
+
+func TestPosition_ConfirmPosition_Closing(t *testing.T) {
+	ctx := context.Background()
+	p := &model.Position{
+		ID:      uuid.NewString(),
+		User:    uuid.NewString(),
+		Name:    "confirm",
+		Amount:  1,
+		Created: time.Now(),
+		Updated: time.Now(),
+	}
+	_, err := testPositionRepository.CreatePosition(ctx, p)
+	require.NoError(t, err)
+	_, err = testPositionRepository.GetNotification(ctx)
+	require.NoError(t, err)
+
+	_, err = testPositionRepository.SetStatus(ctx, p.ID, model.PositionClosing, time.Now())
+	require.NoError(t, err)
+	_, err = testPositionRepository.SetStatus(ctx, p.ID, model.PositionOpen, time.Now())
+	require.ErrorIs(t, err, model.ErrPositionClosing)
+	err = testPositionRepository.ConfirmPosition(ctx, p.ID, time.Now())
+	require.NoError(t, err)
+	pos, err := testPositionRepository.GetPositionByID(ctx, p.ID)
+	require.NoError(t, err)
+	require.Equal(t, model.PositionClosing, pos.Status)
+
+	err = testPositionRepository.RestoreStatus(ctx, p.ID, time.Now())
+	require.NoError(t, err)
+	pos, err = testPositionRepository.GetPositionByID(ctx, p.ID)
+	require.NoError(t, err)
+	require.Equal(t, model.PositionOpen, pos.Status)
+	err = testPositionRepository.RestoreStatus(ctx, p.ID, time.Now())
+	require.ErrorIs(t, err, model.ErrPositionTransition)
+	err = testPositionRepository.ConfirmPosition(ctx, p.ID, time.Now())
+	require.ErrorIs(t, err, model.ErrPositionTransition)
+
+	_, err = testPositionRepository.ClosePosition(ctx, &model.Position{ID: p.ID, Status: model.PositionClosed, Closed: time.Now().Unix(), CloseReason: model.CloseManual, Updated: time.Now()})
+	require.NoError(t, err)
+	_, err = testPositionRepository.GetNotification(ctx)
+	require.NoError(t, err)
+}
+
+func TestPosition_ClosePosition_Details(t *testing.T) {
+	ctx := context.Background()
+	p := &model.Position{
+		ID:            uuid.NewString(),
+		User:          uuid.NewString(),
+		Name:          "details",
+		Amount:        1,
+		PurchasePrice: 100,
+		Created:       time.Now(),
+		Updated:       time.Now(),
+	}
+	_, err := testPositionRepository.CreatePosition(ctx, p)
+	require.NoError(t, err)
+	_, err = testPositionRepository.GetNotification(ctx)
+	require.NoError(t, err)
+
+	p.RealizedPNL = 5
+	err = testPositionRepository.UpdatePosition(ctx, p)
+	require.NoError(t, err)
+
+	closed, err := testPositionRepository.ClosePosition(ctx, &model.Position{
+		ID:           p.ID,
+		Status:       model.PositionClosed,
+		Closed:       time.Now().Unix(),
+		SellingPrice: 110,
+		CloseReason:  model.CloseStopLoss,
+		TriggerPrice: 111,
+		RealizedPNL:  10,
+		Updated:      time.Now(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, 15.0, closed.RealizedPNL)
+	_, err = testPositionRepository.GetNotification(ctx)
+	require.NoError(t, err)
+
+	pos, err := testPositionRepository.GetPositionByID(ctx, p.ID)
+	require.NoError(t, err)
+	require.Equal(t, model.CloseStopLoss, pos.CloseReason)
+	require.Equal(t, 111.0, pos.TriggerPrice)
+	require.Equal(t, 15.0, pos.RealizedPNL)
+
+	positions, err := testPositionRepository.GetUserPositions(ctx, p.User)
+	require.NoError(t, err)
+	require.Len(t, positions, 1)
+	require.Equal(t, model.CloseStopLoss, positions[0].CloseReason)
+}
+
+func TestPosition_ListPositions(t *testing.T) {
+	ctx := context.Background()
+	user := uuid.NewString()
+	start := time.Now().Add(-time.Hour).Truncate(time.Second)
+	var ids []string
+	for i := 0; i < 5; i++ {
+		p := &model.Position{
+			ID:            uuid.NewString(),
+			User:          user,
+			Name:          fmt.Sprintf("history%d", i),
+			Amount:        1,
+			ShortPosition: i%2 == 1,
+			Created:       start.Add(time.Duration(i) * time.Minute),
+			Updated:       time.Now(),
+		}
+		_, err := testPositionRepository.CreatePosition(ctx, p)
+		require.NoError(t, err)
+		ids = append(ids, p.ID)
+	}
+	for _, id := range ids[:2] {
+		_, err := testPositionRepository.ClosePosition(ctx, &model.Position{ID: id, Status: model.PositionClosed, Closed: time.Now().Unix(), CloseReason: model.CloseManual, Updated: time.Now()})
+		require.NoError(t, err)
+	}
+
+	var listed []string
+	cursor := ""
+	for pages := 0; ; pages++ {
+		require.Less(t, pages, 3)
+		page, next, err := testPositionRepository.ListPositions(ctx, &model.PositionFilter{User: user, Limit: 2, Cursor: cursor})
+		require.NoError(t, err)
+		for _, p := range page {
+			listed = append(listed, p.ID)
+		}
+		if next == "" {
+			break
+		}
+		cursor = next
+	}
+	require.Equal(t, ids, listed)
+
+	page, _, err := testPositionRepository.ListPositions(ctx, &model.PositionFilter{User: user, Limit: 10, Descending: true})
+	require.NoError(t, err)
+	require.Equal(t, ids[4], page[0].ID)
+
+	open := true
+	page, _, err = testPositionRepository.ListPositions(ctx, &model.PositionFilter{User: user, Limit: 10, Open: &open})
+	require.NoError(t, err)
+	require.Len(t, page, 3)
+
+	short := true
+	page, _, err = testPositionRepository.ListPositions(ctx, &model.PositionFilter{User: user, Limit: 10, Open: &open, Short: &short})
+	require.NoError(t, err)
+	require.Len(t, page, 1)
+	require.Equal(t, ids[3], page[0].ID)
+
+	page, _, err = testPositionRepository.ListPositions(ctx, &model.PositionFilter{User: user, Limit: 10, Name: "history2",
+		CreatedFrom: start, CreatedTo: start.Add(3 * time.Minute)})
+	require.NoError(t, err)
+	require.Len(t, page, 1)
+	require.Equal(t, ids[2], page[0].ID)
+
+	_, _, err = testPositionRepository.ListPositions(ctx, &model.PositionFilter{User: user, Limit: 10, Cursor: "broken"})
+	require.ErrorIs(t, err, model.ErrInvalidCursor)
+}
+
+func TestPosition_GetNotification_CatchUp(t *testing.T) {
+	ctx := context.Background()
+	past := time.Now().Add(-time.Hour)
+	position := &model.Position{
+		ID:      uuid.NewString(),
+		User:    uuid.NewString(),
+		Name:    "catchup",
+		Amount:  1,
+		Created: past,
+		Updated: past,
+	}
+	_, err := testPositionRepository.CreatePosition(ctx, position)
+	require.NoError(t, err)
+	_, err = testPositionRepository.GetNotification(ctx)
+	require.NoError(t, err)
+
+	err = testPositionRepository.listenConn.Conn().Close(ctx)
+	require.NoError(t, err)
+	_, err = testPositionRepository.ClosePosition(ctx, &model.Position{ID: position.ID, Status: model.PositionClosed, Closed: past.Unix(),
+		CloseReason: model.CloseManual, Updated: past})
+	require.NoError(t, err)
+
+	waitCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	for {
+		notify, notifyErr := testPositionRepository.GetNotification(waitCtx)
+		require.NoError(t, notifyErr)
+		if notify.Position != nil && notify.Position.ID == position.ID && notify.Type == "closed" {
+			break
+		}
+	}
+}
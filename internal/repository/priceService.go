@@ -4,22 +4,33 @@ package repository
 import (
 	"context"
 	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/OVantsevich/Trading-Service/internal/model"
 
 	psProto "github.com/OVantsevich/Price-Service/proto"
 )
 
+// priceReconnectBase delay before the first reconnect attempt
+const priceReconnectBase = 100 * time.Millisecond
+
 // PriceService entity
 type PriceService struct {
 	ctx    context.Context
 	client psProto.PriceServiceClient
-	stream psProto.PriceService_GetPricesClient
+
+	mu            sync.Mutex
+	stream        psProto.PriceService_GetPricesClient
+	subscriptions map[string]struct{}
+	stale         atomic.Bool
+	backoffMax    time.Duration
 }
 
 // NewPriceServiceRepository price service repository constructor
-func NewPriceServiceRepository(ctx context.Context, pspp psProto.PriceServiceClient) (*PriceService, error) {
-	ps := &PriceService{client: pspp, ctx: ctx}
+func NewPriceServiceRepository(ctx context.Context, pspp psProto.PriceServiceClient, backoffMax time.Duration) (*PriceService, error) {
+	ps := &PriceService{client: pspp, ctx: ctx, subscriptions: make(map[string]struct{}), backoffMax: backoffMax}
 	err := ps.subscribe()
 	if err != nil {
 		return nil, fmt.Errorf("priceService - NewPriceServiceRepository - subscribe : %w", err)
@@ -27,12 +38,53 @@ func NewPriceServiceRepository(ctx context.Context, pspp psProto.PriceServiceCli
 	return ps, nil
 }
 
-func (ps *PriceService) subscribe() (err error) {
-	ps.stream, err = ps.client.GetPrices(ps.ctx)
+// subscribe open prices stream and replay all subscribed names, must be called under lock
+func (ps *PriceService) subscribe() error {
+	stream, err := ps.client.GetPrices(ps.ctx)
 	if err != nil {
 		return fmt.Errorf("priceService - subscribe - GetPrices: %w", err)
 	}
-	return
+	if len(ps.subscriptions) > 0 {
+		names := make([]string, 0, len(ps.subscriptions))
+		for name := range ps.subscriptions {
+			names = append(names, name)
+		}
+		err = stream.Send(&psProto.GetPricesRequest{Names: names})
+		if err != nil {
+			return fmt.Errorf("priceService - subscribe - Send: %w", err)
+		}
+	}
+	ps.stream = stream
+	return nil
+}
+
+// reconnect reopen broken stream with exponential backoff until success or context cancellation
+func (ps *PriceService) reconnect() error {
+	delay := priceReconnectBase
+	for {
+		ps.mu.Lock()
+		err := ps.subscribe()
+		if err == nil {
+			ps.stale.Store(false)
+			ps.mu.Unlock()
+			return nil
+		}
+		ps.mu.Unlock()
+		select {
+		case <-ps.ctx.Done():
+			return fmt.Errorf("priceService - reconnect: %w", ps.ctx.Err())
+		case <-time.After(delay):
+		}
+		delay *= 2
+		if delay > ps.backoffMax {
+			delay = ps.backoffMax
+		}
+	}
+}
+
+// Stale true while prices stream is broken and being reconnected
+func (ps *PriceService) Stale() bool {
+	return ps.stale.Load()
 }
 
 // GetCurrentPrices get current prices by names
@@ -45,19 +97,38 @@ func (ps *PriceService) GetCurrentPrices(ctx context.Context, names []string) (m
 	return prices, nil
 }
 
-// GetPrices get prices from price service
+// GetPrices get prices from price service, broken stream is reopened before returning its error
 func (ps *PriceService) GetPrices() ([]*model.Price, error) {
-	response, err := ps.stream.Recv()
+	ps.mu.Lock()
+	stream := ps.stream
+	ps.mu.Unlock()
+
+	response, err := stream.Recv()
 	if err != nil {
+		ps.mu.Lock()
+		ps.stale.Store(true)
+		ps.mu.Unlock()
+		if recErr := ps.reconnect(); recErr != nil {
+			return nil, fmt.Errorf("priceService - GetPrices - reconnect: %v: %w", recErr, err)
+		}
 		return nil, fmt.Errorf("priceService - GetPrices - Recv: %w", err)
 	}
 	return fromGRPC(response.Prices), nil
 }
 
-// UpdateSubscription subscribe for new prices
+// UpdateSubscription subscribe for new prices, names are remembered and resubscribed after reconnect
 func (ps *PriceService) UpdateSubscription(names []string) error {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+	for _, name := range names {
+		ps.subscriptions[name] = struct{}{}
+	}
+	if ps.stale.Load() {
+		return nil
+	}
 	err := ps.stream.Send(&psProto.GetPricesRequest{Names: names})
 	if err != nil {
+		ps.stale.Store(true)
 		return fmt.Errorf("priceService - UpdateSubscription - Send: %w", err)
 	}
 	return nil
@@ -0,0 +1,101 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	psProto "github.com/OVantsevich/Price-Service/proto"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+type testPricesStream struct {
+	grpc.ClientStream
+	mu       sync.Mutex
+	sent     [][]string
+	received chan *psProto.GetPricesResponse
+}
+
+func (s *testPricesStream) Send(request *psProto.GetPricesRequest) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.sent = append(s.sent, request.Names)
+	return nil
+}
+
+func (s *testPricesStream) Recv() (*psProto.GetPricesResponse, error) {
+	response, ok := <-s.received
+	if !ok {
+		return nil, fmt.Errorf("stream closed")
+	}
+	return response, nil
+}
+
+type testPriceClient struct {
+	psProto.PriceServiceClient
+	mu       sync.Mutex
+	failures int
+	streams  []*testPricesStream
+}
+
+func (c *testPriceClient) GetPrices(_ context.Context, _ ...grpc.CallOption) (psProto.PriceService_GetPricesClient, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if len(c.streams) > 0 && c.failures > 0 {
+		c.failures--
+		return nil, fmt.Errorf("unavailable")
+	}
+	stream := &testPricesStream{received: make(chan *psProto.GetPricesResponse, 1)}
+	c.streams = append(c.streams, stream)
+	return stream, nil
+}
+
+func TestPriceService_Reconnect(t *testing.T) {
+	client := &testPriceClient{failures: 2}
+	ps, err := NewPriceServiceRepository(context.Background(), client, time.Millisecond*300)
+	require.NoError(t, err)
+
+	err = ps.UpdateSubscription([]string{"first"})
+	require.NoError(t, err)
+	err = ps.UpdateSubscription([]string{"second"})
+	require.NoError(t, err)
+
+	client.streams[0].received <- &psProto.GetPricesResponse{Prices: []*psProto.Price{{Name: "first", SellingPrice: 1}}}
+	prices, err := ps.GetPrices()
+	require.NoError(t, err)
+	require.Equal(t, "first", prices[0].Name)
+	require.False(t, ps.Stale())
+
+	close(client.streams[0].received)
+	_, err = ps.GetPrices()
+	require.Error(t, err)
+	require.False(t, ps.Stale())
+	require.Len(t, client.streams, 2)
+	require.Len(t, client.streams[1].sent, 1)
+	require.ElementsMatch(t, []string{"first", "second"}, client.streams[1].sent[0])
+
+	client.streams[1].received <- &psProto.GetPricesResponse{Prices: []*psProto.Price{{Name: "second", SellingPrice: 2}}}
+	prices, err = ps.GetPrices()
+	require.NoError(t, err)
+	require.Equal(t, "second", prices[0].Name)
+}
+
+func TestPriceService_SubscribeWhileStale(t *testing.T) {
+	client := &testPriceClient{}
+	ps, err := NewPriceServiceRepository(context.Background(), client, time.Millisecond)
+	require.NoError(t, err)
+
+	ps.stale.Store(true)
+	err = ps.UpdateSubscription([]string{"name"})
+	require.NoError(t, err)
+	require.True(t, ps.Stale())
+	require.Len(t, client.streams[0].sent, 0)
+
+	err = ps.reconnect()
+	require.NoError(t, err)
+	require.False(t, ps.Stale())
+	require.Equal(t, [][]string{{"name"}}, client.streams[1].sent)
+}
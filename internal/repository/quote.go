@@ -0,0 +1,79 @@
+// Package repository quote
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/OVantsevich/Trading-Service/internal/model"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// Quote postgres entity
+type Quote struct {
+	PgxWithinTransactionRunner
+}
+
+// NewQuoteRepository creating new Quote repository
+func NewQuoteRepository(p PgxWithinTransactionRunner) *Quote {
+	return &Quote{PgxWithinTransactionRunner: p}
+}
+
+// CreateQuote create quote
+func (q *Quote) CreateQuote(ctx context.Context, quote *model.Quote) error {
+	_, err := q.Exec(ctx, `insert into quotes (id, "user", "name", amount, short_position, purchase_price, selling_price, expires, created)
+				values ($1, $2, $3, $4, $5, $6, $7, $8, $9);`,
+		quote.ID, quote.User, quote.Name, quote.Amount, quote.ShortPosition, quote.PurchasePrice, quote.SellingPrice, quote.Expires, quote.Created)
+	if err != nil {
+		return fmt.Errorf("quote - CreateQuote - Exec: %w", err)
+	}
+
+	return nil
+}
+
+// ExecuteQuote mark quote executed if it is neither expired nor executed yet,
+// concurrent executions of the same quote are serialized by row lock, so only one of them succeeds
+func (q *Quote) ExecuteQuote(ctx context.Context, id string, executed time.Time) (*model.Quote, error) {
+	quote := &model.Quote{ID: id, Executed: executed}
+	row := q.QueryRow(ctx, `update quotes set executed=$2 where id=$1 and executed is null and expires > $2
+				returning "user", "name", amount, short_position, purchase_price, selling_price, expires, created;`,
+		id, executed)
+	err := row.Scan(&quote.User, &quote.Name, &quote.Amount, &quote.ShortPosition, &quote.PurchasePrice, &quote.SellingPrice, &quote.Expires, &quote.Created)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, fmt.Errorf("quote - ExecuteQuote: %w", q.rejectionError(ctx, id))
+	}
+	if err != nil {
+		return nil, fmt.Errorf("quote - ExecuteQuote - Scan: %w", err)
+	}
+
+	return quote, nil
+}
+
+// rejectionError why quote can't be executed
+func (q *Quote) rejectionError(ctx context.Context, id string) error {
+	var used bool
+	err := q.QueryRow(ctx, `select executed is not null from quotes where id = $1`, id).Scan(&used)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return model.ErrQuoteNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("quote - rejectionError - Scan: %w", err)
+	}
+	if used {
+		return model.ErrQuoteUsed
+	}
+	return model.ErrQuoteExpired
+}
+
+// SetQuotePosition set position opened by quote
+func (q *Quote) SetQuotePosition(ctx context.Context, id, positionID string) error {
+	_, err := q.Exec(ctx, `update quotes set position_id=$1 where id=$2;`, positionID, id)
+	if err != nil {
+		return fmt.Errorf("quote - SetQuotePosition - Exec: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,47 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/OVantsevich/Trading-Service/internal/model"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQuote_ExecuteQuote(t *testing.T) {
+	ctx := context.Background()
+	quote := &model.Quote{
+		ID:            uuid.NewString(),
+		User:          uuid.NewString(),
+		Name:          "quote",
+		Amount:        2,
+		PurchasePrice: 101,
+		SellingPrice:  100,
+		Created:       time.Now(),
+		Expires:       time.Now().Add(time.Minute),
+	}
+	err := testQuoteRepository.CreateQuote(ctx, quote)
+	require.NoError(t, err)
+
+	executed, err := testQuoteRepository.ExecuteQuote(ctx, quote.ID, time.Now())
+	require.NoError(t, err)
+	require.Equal(t, quote.User, executed.User)
+	require.Equal(t, quote.PurchasePrice, executed.PurchasePrice)
+	err = testQuoteRepository.SetQuotePosition(ctx, quote.ID, uuid.NewString())
+	require.NoError(t, err)
+
+	_, err = testQuoteRepository.ExecuteQuote(ctx, quote.ID, time.Now())
+	require.ErrorIs(t, err, model.ErrQuoteUsed)
+	_, err = testQuoteRepository.ExecuteQuote(ctx, uuid.NewString(), time.Now())
+	require.ErrorIs(t, err, model.ErrQuoteNotFound)
+
+	quote.ID = uuid.NewString()
+	quote.Expires = time.Now().Add(-time.Second)
+	err = testQuoteRepository.CreateQuote(ctx, quote)
+	require.NoError(t, err)
+	_, err = testQuoteRepository.ExecuteQuote(ctx, quote.ID, time.Now())
+	require.ErrorIs(t, err, model.ErrQuoteExpired)
+}
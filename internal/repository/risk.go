@@ -0,0 +1,56 @@
+// Package repository risk
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/OVantsevich/Trading-Service/internal/model"
+)
+
+// Risk postgres entity
+type Risk struct {
+	PgxWithinTransactionRunner
+}
+
+// NewRiskRepository creating new Risk repository
+func NewRiskRepository(p PgxWithinTransactionRunner) *Risk {
+	return &Risk{PgxWithinTransactionRunner: p}
+}
+
+// GetRiskLimits get limits of user applying to instrument and lock them,
+// so concurrent checks of the same user are serialized within their transactions
+func (r *Risk) GetRiskLimits(ctx context.Context, userID, name string) ([]*model.RiskLimits, error) {
+	rows, err := r.Query(ctx, `select "name", max_open_positions, max_notional, max_exposure, max_short_exposure, updated
+				from risk_limits where "user" = $1 and ("name" = '' or "name" = $2) for update`, userID, name)
+	if err != nil {
+		return nil, fmt.Errorf("risk - GetRiskLimits - Query: %w", err)
+	}
+	defer rows.Close()
+
+	var result []*model.RiskLimits
+	for rows.Next() {
+		limits := &model.RiskLimits{User: userID}
+		err = rows.Scan(&limits.Name, &limits.MaxOpenPositions, &limits.MaxNotional, &limits.MaxExposure, &limits.MaxShortExposure, &limits.Updated)
+		if err != nil {
+			return nil, fmt.Errorf("risk - GetRiskLimits - Scan: %w", err)
+		}
+		result = append(result, limits)
+	}
+
+	return result, nil
+}
+
+// SetRiskLimits create or replace limits of user
+func (r *Risk) SetRiskLimits(ctx context.Context, limits *model.RiskLimits) error {
+	_, err := r.Exec(ctx, `insert into risk_limits ("user", "name", max_open_positions, max_notional, max_exposure, max_short_exposure, updated)
+				values ($1, $2, $3, $4, $5, $6, $7)
+				on conflict ("user", "name") do update set max_open_positions = excluded.max_open_positions, max_notional = excluded.max_notional,
+				max_exposure = excluded.max_exposure, max_short_exposure = excluded.max_short_exposure, updated = excluded.updated;`,
+		limits.User, limits.Name, limits.MaxOpenPositions, limits.MaxNotional, limits.MaxExposure, limits.MaxShortExposure, limits.Updated)
+	if err != nil {
+		return fmt.Errorf("risk - SetRiskLimits - Exec: %w", err)
+	}
+
+	return nil
+}
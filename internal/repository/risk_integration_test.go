@@ -0,0 +1,39 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/OVantsevich/Trading-Service/internal/model"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRisk_RiskLimits(t *testing.T) {
+	ctx := context.Background()
+	user := uuid.NewString()
+
+	err := testRiskRepository.SetRiskLimits(ctx, &model.RiskLimits{User: user, MaxOpenPositions: 1, Updated: time.Now()})
+	require.NoError(t, err)
+	err = testRiskRepository.SetRiskLimits(ctx, &model.RiskLimits{User: user, MaxOpenPositions: 2, MaxExposure: 1000, Updated: time.Now()})
+	require.NoError(t, err)
+	err = testRiskRepository.SetRiskLimits(ctx, &model.RiskLimits{User: user, Name: "risk", MaxNotional: 500, Updated: time.Now()})
+	require.NoError(t, err)
+	err = testRiskRepository.SetRiskLimits(ctx, &model.RiskLimits{User: user, Name: "other", MaxNotional: 100, Updated: time.Now()})
+	require.NoError(t, err)
+
+	limits, err := testRiskRepository.GetRiskLimits(ctx, user, "risk")
+	require.NoError(t, err)
+	require.Len(t, limits, 2)
+	for _, l := range limits {
+		if l.Name == "" {
+			require.Equal(t, 2, l.MaxOpenPositions)
+			require.Equal(t, 1000.0, l.MaxExposure)
+		} else {
+			require.Equal(t, "risk", l.Name)
+			require.Equal(t, 500.0, l.MaxNotional)
+		}
+	}
+}
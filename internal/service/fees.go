@@ -0,0 +1,56 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/OVantsevich/Trading-Service/internal/model"
+
+	"github.com/google/uuid"
+)
+
+// CommissionRepository commission schedules of instruments and user tiers
+//
+//go:generate mockery --name=CommissionRepository --case=underscore --output=./mocks
+type CommissionRepository interface {
+	GetCommissionSchedule(ctx context.Context, userID, name string) (*model.CommissionSchedule, error)
+	CreateCommissionSchedule(ctx context.Context, schedule *model.CommissionSchedule) error
+	SetUserTier(ctx context.Context, userID, tier string) error
+}
+
+// SetCommissionSchedule create or replace commission of instrument and tier,
+// empty name or tier sets commission of any instrument or tier
+func (t *Trading) SetCommissionSchedule(ctx context.Context, schedule *model.CommissionSchedule) error {
+	if schedule.Kind != model.CommissionFlat && schedule.Kind != model.CommissionPercentage || schedule.Value < 0 {
+		return fmt.Errorf("trading - SetCommissionSchedule: %w", model.ErrInvalidCommission)
+	}
+	schedule.ID = uuid.New().String()
+	schedule.Created = time.Now()
+	err := t.commissions.CreateCommissionSchedule(ctx, schedule)
+	if err != nil {
+		return fmt.Errorf("trading - SetCommissionSchedule - CreateCommissionSchedule: %w", err)
+	}
+	return nil
+}
+
+// SetUserTier set commission tier of user, empty tier returns user to default commissions
+func (t *Trading) SetUserTier(ctx context.Context, userID, tier string) error {
+	err := t.commissions.SetUserTier(ctx, userID, tier)
+	if err != nil {
+		return fmt.Errorf("trading - SetUserTier - SetUserTier: %w", err)
+	}
+	return nil
+}
+
+// fee commission of user's trade in instrument with given notional, trades without schedule are free
+func (t *Trading) fee(ctx context.Context, userID, name string, notional float64) (float64, error) {
+	schedule, err := t.commissions.GetCommissionSchedule(ctx, userID, name)
+	if err != nil {
+		return 0, fmt.Errorf("trading - fee - GetCommissionSchedule: %w", err)
+	}
+	if schedule == nil {
+		return 0, nil
+	}
+	return schedule.Fee(notional), nil
+}
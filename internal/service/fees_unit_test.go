@@ -0,0 +1,103 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/OVantsevich/Trading-Service/internal/model"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTrading_CreatePosition_Fee(t *testing.T) {
+	trading, m := newMockedTrading(t)
+	position := &model.Position{User: uuid.NewString(), Name: "fee", Amount: 10, Leverage: 2}
+
+	m.prices.On("GetCurrentPrices", mock.Anything, []string{position.Name}).Return(
+		map[string]*model.Price{position.Name: {Name: position.Name, PurchasePrice: 100, SellingPrice: 100}}, nil)
+	m.commissions.On("GetCommissionSchedule", mock.Anything, position.User, position.Name).Return(percentageCommission(0.1), nil)
+	m.positions.On("CreatePosition", mock.Anything, mock.MatchedBy(func(pos *model.Position) bool {
+		return pos.Fees == 1 && pos.RealizedPNL == -1 && pos.Margin == 500
+	})).Return(position, nil)
+	m.outbox.On("CreatePaymentCommand", mock.Anything, payment(model.PaymentOpen, -501)).Return(nil)
+
+	pos, err := trading.CreatePosition(context.Background(), position, nil)
+	require.NoError(t, err)
+	require.Equal(t, 1.0, pos.Fees)
+}
+
+func TestTrading_ClosePosition_Fee(t *testing.T) {
+	trading, m := newMockedTrading(t)
+	position := &model.Position{ID: uuid.NewString(), User: uuid.NewString(), Name: "fee", Amount: 10, PurchasePrice: 100, Leverage: 2,
+		Margin: 500, Fees: 1, RealizedPNL: -1, Status: model.PositionOpen}
+
+	m.positions.On("SetStatus", mock.Anything, position.ID, model.PositionClosing, mock.Anything).Return(model.PositionOpen, nil)
+	m.positions.On("GetPositionByID", mock.Anything, position.ID).Return(position, nil)
+	m.prices.On("GetCurrentPrices", mock.Anything, []string{position.Name}).Return(
+		map[string]*model.Price{position.Name: {Name: position.Name, PurchasePrice: 110, SellingPrice: 110}}, nil)
+	m.commissions.On("GetCommissionSchedule", mock.Anything, position.User, position.Name).Return(
+		&model.CommissionSchedule{Kind: model.CommissionFlat, Value: 3}, nil)
+	m.positions.On("ClosePosition", mock.Anything, mock.MatchedBy(func(pos *model.Position) bool {
+		return pos.Fees == 3 && pos.RealizedPNL == 97
+	})).Return(position, nil)
+	m.positions.On("CreateFill", mock.Anything, mock.Anything).Return(nil)
+	m.outbox.On("CreatePaymentCommand", mock.Anything, payment(model.PaymentSettle, 597)).Return(nil)
+
+	err := trading.ClosePosition(context.Background(), position.ID, nil, time.Now().Unix(), time.Now())
+	require.NoError(t, err)
+}
+
+func TestTrading_PartialClosePosition_Fee(t *testing.T) {
+	trading, m := newMockedTrading(t)
+	position := &model.Position{ID: uuid.NewString(), User: uuid.NewString(), Name: "fee", Amount: 10, PurchasePrice: 100, Leverage: 1,
+		Margin: 1000, MaintenanceMargin: 50, Fees: 1, RealizedPNL: -1, Status: model.PositionOpen}
+
+	m.positions.On("GetPositionByID", mock.Anything, position.ID).Return(position, nil)
+	m.prices.On("GetCurrentPrices", mock.Anything, []string{position.Name}).Return(
+		map[string]*model.Price{position.Name: {Name: position.Name, PurchasePrice: 90, SellingPrice: 90}}, nil)
+	m.commissions.On("GetCommissionSchedule", mock.Anything, position.User, position.Name).Return(percentageCommission(1), nil)
+	m.positions.On("UpdatePosition", mock.Anything, mock.MatchedBy(func(pos *model.Position) bool {
+		return pos.Amount == 6 && pos.Fees == 4.6 && pos.RealizedPNL == -44.6
+	})).Return(nil)
+	m.positions.On("CreateFill", mock.Anything, mock.Anything).Return(nil)
+	m.outbox.On("CreatePaymentCommand", mock.Anything, payment(model.PaymentSettle, 356.4)).Return(nil)
+
+	err := trading.PartialClosePosition(context.Background(), position.ID, 4, nil, time.Now())
+	require.NoError(t, err)
+}
+
+func TestTrading_CompensatePayment_RefundsFees(t *testing.T) {
+	trading, m := newMockedTrading(t)
+	position := &model.Position{ID: uuid.NewString(), User: uuid.NewString(), Name: "fee", Amount: 10, PurchasePrice: 100,
+		Fees: 1, RealizedPNL: -1, Status: model.PositionPending}
+	command := &model.PaymentCommand{ID: uuid.NewString(), PositionID: position.ID, Reason: model.PaymentOpen, Updated: time.Now()}
+
+	m.outbox.On("CancelPaymentCommands", mock.Anything, position.ID, command.Updated).Return(nil)
+	m.positions.On("GetPositionByID", mock.Anything, position.ID).Return(position, nil)
+	m.positions.On("SetLiquidationReason", mock.Anything, position.ID, model.LiquidationPaymentFailed, command.Updated).Return(nil)
+	m.positions.On("ClosePosition", mock.Anything, mock.MatchedBy(func(pos *model.Position) bool {
+		return pos.Status == model.PositionFailed && pos.Fees == -1 && pos.RealizedPNL == 1
+	})).Return(position, nil)
+
+	compensated, err := trading.compensatePayment(context.Background(), command)
+	require.NoError(t, err)
+	require.True(t, compensated)
+}
+
+func TestTrading_SetCommissionSchedule(t *testing.T) {
+	trading, m := newMockedTrading(t)
+
+	err := trading.SetCommissionSchedule(context.Background(), &model.CommissionSchedule{Kind: "unknown", Value: 1})
+	require.ErrorIs(t, err, model.ErrInvalidCommission)
+	err = trading.SetCommissionSchedule(context.Background(), &model.CommissionSchedule{Kind: model.CommissionFlat, Value: -1})
+	require.ErrorIs(t, err, model.ErrInvalidCommission)
+
+	m.commissions.On("CreateCommissionSchedule", mock.Anything, mock.MatchedBy(func(schedule *model.CommissionSchedule) bool {
+		return schedule.ID != "" && schedule.Name == "fee" && schedule.Tier == "vip"
+	})).Return(nil)
+	err = trading.SetCommissionSchedule(context.Background(), &model.CommissionSchedule{Name: "fee", Tier: "vip", Kind: model.CommissionFlat, Value: 1})
+	require.NoError(t, err)
+}
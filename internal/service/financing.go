@@ -0,0 +1,155 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/OVantsevich/Trading-Service/internal/model"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+)
+
+// FinancingRepository financing rates of instruments and accruals of positions
+//
+//go:generate mockery --name=FinancingRepository --case=underscore --output=./mocks
+type FinancingRepository interface {
+	GetFinancingRates(ctx context.Context) (map[string]*model.FinancingRate, error)
+	SetFinancingRate(ctx context.Context, rate *model.FinancingRate) error
+	CreateAccrual(ctx context.Context, accrual *model.FinancingAccrual) (bool, error)
+}
+
+// SetFinancingRate create or replace daily financing rates of instrument
+func (t *Trading) SetFinancingRate(ctx context.Context, rate *model.FinancingRate) error {
+	if rate.LongRate < 0 || rate.ShortRate < 0 {
+		return fmt.Errorf("trading - SetFinancingRate: %w", model.ErrInvalidFinancingRate)
+	}
+	rate.Updated = time.Now()
+	err := t.financing.SetFinancingRate(ctx, rate)
+	if err != nil {
+		return fmt.Errorf("trading - SetFinancingRate - SetFinancingRate: %w", err)
+	}
+	return nil
+}
+
+// lastRollover the latest daily rollover at or before now, rollover happens at offset from midnight UTC
+func lastRollover(now time.Time, offset time.Duration) time.Time {
+	now = now.UTC()
+	rollover := now.Truncate(24 * time.Hour).Add(offset)
+	if rollover.After(now) {
+		rollover = rollover.Add(-24 * time.Hour)
+	}
+	return rollover
+}
+
+// accrueFinancing charge financing of the latest rollover from positions which were open at it at their instrument rate,
+// each position is charged once per rollover, returns number of charged positions
+func (t *Trading) accrueFinancing(ctx context.Context, now time.Time) (int, error) {
+	rollover := lastRollover(now, t.financingRollover)
+	rates, err := t.financing.GetFinancingRates(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("trading - accrueFinancing - GetFinancingRates: %w", err)
+	}
+	positions, err := t.positionsRepository.GetOpenPositions(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("trading - accrueFinancing - GetOpenPositions: %w", err)
+	}
+
+	var due []*model.Position
+	names := make([]string, 0)
+	subscribed := make(map[string]bool)
+	for _, pos := range positions {
+		rate, ok := rates[pos.Name]
+		if !ok || rate.Rate(pos) == 0 || pos.Status != model.PositionOpen || !pos.Created.Before(rollover) {
+			continue
+		}
+		due = append(due, pos)
+		if !subscribed[pos.Name] {
+			subscribed[pos.Name] = true
+			names = append(names, pos.Name)
+		}
+	}
+	if len(due) == 0 {
+		return 0, nil
+	}
+
+	prices, err := t.priceService.GetCurrentPrices(ctx, names)
+	if err != nil {
+		return 0, fmt.Errorf("trading - accrueFinancing - GetCurrentPrices: %w", err)
+	}
+	var charged int
+	for _, pos := range due {
+		price, ok := prices[pos.Name]
+		if !ok {
+			logrus.Warnf("trading - accrueFinancing: no price of %s, financing of position %s is postponed", pos.Name, pos.ID)
+			continue
+		}
+		accrued, err := t.accruePosition(ctx, pos, rates[pos.Name].Rate(pos), price.SellingPrice, rollover, now)
+		if err != nil {
+			return charged, fmt.Errorf("trading - accrueFinancing - accruePosition: %w", err)
+		}
+		if accrued {
+			charged++
+		}
+	}
+	return charged, nil
+}
+
+// accruePosition record financing of position for rollover and debit it by payment outbox,
+// returns false if position was already charged for rollover or isn't open anymore
+func (t *Trading) accruePosition(ctx context.Context, pos *model.Position, rate, price float64, rollover, now time.Time) (bool, error) {
+	notional := pos.Amount * price
+	accrual := &model.FinancingAccrual{
+		ID:         uuid.New().String(),
+		PositionID: pos.ID,
+		User:       pos.User,
+		Name:       pos.Name,
+		Rollover:   rollover,
+		Rate:       rate,
+		Notional:   notional,
+		Amount:     notional * rate / 100,
+		Created:    now,
+	}
+	var accrued bool
+	err := t.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		created, err := t.financing.CreateAccrual(ctx, accrual)
+		if err != nil {
+			return fmt.Errorf("trading - accruePosition - CreateAccrual: %w", err)
+		}
+		if !created {
+			return nil
+		}
+		err = t.positionsRepository.AddFinancing(ctx, pos.ID, accrual.Amount, now)
+		if err != nil {
+			return fmt.Errorf("trading - accruePosition - AddFinancing: %w", err)
+		}
+		err = t.enqueuePayment(ctx, pos, -accrual.Amount, model.PaymentFinancing, nil)
+		if err != nil {
+			return fmt.Errorf("trading - accruePosition - enqueuePayment: %w", err)
+		}
+		accrued = true
+		return nil
+	})
+	if closeRejected(err) || errors.Is(err, model.ErrPositionTransition) {
+		return false, nil
+	}
+	return accrued, err
+}
+
+func financingListener(ctx context.Context, t *Trading, errChan chan error) {
+	ticker := time.NewTicker(t.financingInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			_, err := t.accrueFinancing(ctx, time.Now())
+			if err != nil {
+				errChan <- err
+			}
+		}
+	}
+}
@@ -0,0 +1,74 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/OVantsevich/Trading-Service/internal/model"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLastRollover(t *testing.T) {
+	after := time.Date(2026, 10, 18, 23, 0, 0, 0, time.UTC)
+	require.Equal(t, time.Date(2026, 10, 18, 22, 0, 0, 0, time.UTC), lastRollover(after, 22*time.Hour))
+	before := time.Date(2026, 10, 18, 21, 0, 0, 0, time.UTC)
+	require.Equal(t, time.Date(2026, 10, 17, 22, 0, 0, 0, time.UTC), lastRollover(before, 22*time.Hour))
+}
+
+func TestTrading_AccrueFinancing(t *testing.T) {
+	trading, m := newMockedTrading(t)
+	now := time.Date(2026, 10, 18, 23, 0, 0, 0, time.UTC)
+	held := now.Add(-48 * time.Hour)
+	newPosition := func(name string, short bool, status string, created time.Time) *model.Position {
+		return &model.Position{ID: uuid.NewString(), User: uuid.NewString(), Name: name, Amount: 10, PurchasePrice: 90,
+			ShortPosition: short, Status: status, Created: created}
+	}
+	short := newPosition("financed", true, model.PositionOpen, held)
+	accrued := newPosition("financed", true, model.PositionOpen, held)
+	closed := newPosition("financed", true, model.PositionOpen, held)
+	positions := []*model.Position{
+		short, accrued, closed,
+		newPosition("financed", false, model.PositionOpen, held),
+		newPosition("financed", true, model.PositionOpen, now.Add(-time.Minute)),
+		newPosition("financed", true, model.PositionPending, held),
+		newPosition("free", true, model.PositionOpen, held),
+	}
+
+	m.financing.On("GetFinancingRates", mock.Anything).Return(map[string]*model.FinancingRate{
+		"financed": {Name: "financed", ShortRate: 0.01},
+	}, nil)
+	m.positions.On("GetOpenPositions", mock.Anything).Return(positions, nil)
+	m.prices.On("GetCurrentPrices", mock.Anything, []string{"financed"}).Return(
+		map[string]*model.Price{"financed": {Name: "financed", SellingPrice: 100}}, nil)
+	m.financing.On("CreateAccrual", mock.Anything, mock.MatchedBy(func(accrual *model.FinancingAccrual) bool {
+		return accrual.PositionID == accrued.ID
+	})).Return(false, nil)
+	m.financing.On("CreateAccrual", mock.Anything, mock.MatchedBy(func(accrual *model.FinancingAccrual) bool {
+		return accrual.PositionID != accrued.ID && accrual.Notional == 1000 && accrual.Amount == 0.1 &&
+			accrual.Rollover.Equal(time.Date(2026, 10, 18, 22, 0, 0, 0, time.UTC))
+	})).Return(true, nil)
+	m.positions.On("AddFinancing", mock.Anything, short.ID, 0.1, now).Return(nil)
+	m.positions.On("AddFinancing", mock.Anything, closed.ID, 0.1, now).Return(model.ErrPositionClosed)
+	m.outbox.On("CreatePaymentCommand", mock.Anything, payment(model.PaymentFinancing, -0.1)).Return(nil).Once()
+
+	charged, err := trading.accrueFinancing(context.Background(), now)
+	require.NoError(t, err)
+	require.Equal(t, 1, charged)
+}
+
+func TestTrading_SetFinancingRate(t *testing.T) {
+	trading, m := newMockedTrading(t)
+
+	err := trading.SetFinancingRate(context.Background(), &model.FinancingRate{Name: "financed", ShortRate: -1})
+	require.ErrorIs(t, err, model.ErrInvalidFinancingRate)
+
+	m.financing.On("SetFinancingRate", mock.Anything, mock.MatchedBy(func(rate *model.FinancingRate) bool {
+		return rate.Name == "financed" && !rate.Updated.IsZero()
+	})).Return(nil)
+	err = trading.SetFinancingRate(context.Background(), &model.FinancingRate{Name: "financed", ShortRate: 0.01})
+	require.NoError(t, err)
+}
@@ -0,0 +1,80 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/OVantsevich/Trading-Service/internal/model"
+)
+
+// IdempotencyRepository idempotency keys repository
+//
+//go:generate mockery --name=IdempotencyRepository --case=underscore --output=./mocks
+type IdempotencyRepository interface {
+	CreateKey(ctx context.Context, key *model.IdempotencyKey, staleBefore time.Time) (bool, error)
+	GetKey(ctx context.Context, key string) (*model.IdempotencyKey, error)
+	SaveResponse(ctx context.Context, key string, response []byte) error
+	DeleteKey(ctx context.Context, key string) error
+}
+
+// Idempotent execute call once per idempotency key, replays stored response of completed call
+// key of request which didn't respond within idempotency timeout is taken over by its retry
+func (t *Trading) Idempotent(ctx context.Context, key, method string, requestHash []byte, call func() ([]byte, error)) ([]byte, error) {
+	now := time.Now()
+	created, err := t.idempotencyRepository.CreateKey(ctx, &model.IdempotencyKey{
+		Key:         key,
+		Method:      method,
+		RequestHash: requestHash,
+		Created:     now,
+	}, now.Add(-t.idempotencyTimeout))
+	if err != nil {
+		return nil, fmt.Errorf("trading - Idempotent - CreateKey: %w", err)
+	}
+
+	if !created {
+		var stored *model.IdempotencyKey
+		stored, err = t.idempotencyRepository.GetKey(ctx, key)
+		if err != nil {
+			return nil, fmt.Errorf("trading - Idempotent - GetKey: %w", err)
+		}
+		if stored.Method != method || !bytes.Equal(stored.RequestHash, requestHash) {
+			return nil, fmt.Errorf("trading - Idempotent: %w", model.ErrIdempotencyKeyReused)
+		}
+		if stored.Response == nil {
+			return nil, fmt.Errorf("trading - Idempotent: %w", model.ErrRequestInProgress)
+		}
+		return stored.Response, nil
+	}
+
+	// key is released or completed even if caller is gone, otherwise it stays in progress until timeout
+	detached := withoutCancel{ctx}
+	response, err := call()
+	if err != nil {
+		if delErr := t.idempotencyRepository.DeleteKey(detached, key); delErr != nil {
+			return nil, fmt.Errorf("trading - Idempotent - DeleteKey: %v: %w", delErr, err)
+		}
+		return nil, err
+	}
+
+	err = t.idempotencyRepository.SaveResponse(detached, key, response)
+	if err != nil {
+		return nil, fmt.Errorf("trading - Idempotent - SaveResponse: %w", err)
+	}
+	return response, nil
+}
+
+// withoutCancel context which keeps values of parent but is never canceled with it
+type withoutCancel struct {
+	context.Context
+}
+
+// Deadline no deadline
+func (withoutCancel) Deadline() (deadline time.Time, ok bool) { return }
+
+// Done never closed
+func (withoutCancel) Done() <-chan struct{} { return nil }
+
+// Err never canceled
+func (withoutCancel) Err() error { return nil }
@@ -0,0 +1,57 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/OVantsevich/Trading-Service/internal/model"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTrading_Idempotent_CanceledCaller(t *testing.T) {
+	trading, m := newMockedTrading(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	active := mock.MatchedBy(func(ctx context.Context) bool { return ctx.Err() == nil })
+
+	m.idempotency.On("CreateKey", mock.Anything, mock.Anything, mock.MatchedBy(func(staleBefore time.Time) bool {
+		return time.Since(staleBefore) >= time.Minute
+	})).Return(true, nil)
+	m.idempotency.On("DeleteKey", active, "failed").Return(nil)
+	m.idempotency.On("SaveResponse", active, "completed", []byte("response")).Return(nil)
+
+	_, err := trading.Idempotent(ctx, "failed", "OpenPosition", []byte("hash"), func() ([]byte, error) {
+		cancel()
+		return nil, ctx.Err()
+	})
+	require.ErrorIs(t, err, context.Canceled)
+
+	ctx, cancel = context.WithCancel(context.Background())
+	response, err := trading.Idempotent(ctx, "completed", "OpenPosition", []byte("hash"), func() ([]byte, error) {
+		cancel()
+		return []byte("response"), nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, []byte("response"), response)
+}
+
+func TestTrading_Idempotent_Stored(t *testing.T) {
+	trading, m := newMockedTrading(t)
+
+	m.idempotency.On("CreateKey", mock.Anything, mock.Anything, mock.Anything).Return(false, nil)
+	m.idempotency.On("GetKey", mock.Anything, "progress").Return(
+		&model.IdempotencyKey{Key: "progress", Method: "OpenPosition", RequestHash: []byte("hash")}, nil)
+	m.idempotency.On("GetKey", mock.Anything, "done").Return(
+		&model.IdempotencyKey{Key: "done", Method: "OpenPosition", RequestHash: []byte("hash"), Response: []byte("response")}, nil)
+
+	call := func() ([]byte, error) { return nil, nil }
+	_, err := trading.Idempotent(context.Background(), "progress", "OpenPosition", []byte("hash"), call)
+	require.ErrorIs(t, err, model.ErrRequestInProgress)
+	_, err = trading.Idempotent(context.Background(), "done", "ClosePosition", []byte("hash"), call)
+	require.ErrorIs(t, err, model.ErrIdempotencyKeyReused)
+	response, err := trading.Idempotent(context.Background(), "done", "OpenPosition", []byte("hash"), call)
+	require.NoError(t, err)
+	require.Equal(t, []byte("response"), response)
+}
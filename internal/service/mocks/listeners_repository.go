@@ -37,13 +37,13 @@ func (_m *ListenersRepository) ClosePosition(ctx context.Context) (*model.Positi
 	return r0, r1
 }
 
-// CreateListenerSL provides a mock function with given fields: ctx, notify
-func (_m *ListenersRepository) CreateListenerSL(ctx context.Context, notify *model.Notification) error {
-	ret := _m.Called(ctx, notify)
+// CreateListenerSL provides a mock function with given fields: ctx, position
+func (_m *ListenersRepository) CreateListenerSL(ctx context.Context, position *model.Position) error {
+	ret := _m.Called(ctx, position)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, *model.Notification) error); ok {
-		r0 = rf(ctx, notify)
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Position) error); ok {
+		r0 = rf(ctx, position)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -51,13 +51,13 @@ func (_m *ListenersRepository) CreateListenerSL(ctx context.Context, notify *mod
 	return r0
 }
 
-// CreateListenerTP provides a mock function with given fields: ctx, notify
-func (_m *ListenersRepository) CreateListenerTP(ctx context.Context, notify *model.Notification) error {
-	ret := _m.Called(ctx, notify)
+// CreateListenerTP provides a mock function with given fields: ctx, position
+func (_m *ListenersRepository) CreateListenerTP(ctx context.Context, position *model.Position) error {
+	ret := _m.Called(ctx, position)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, *model.Notification) error); ok {
-		r0 = rf(ctx, notify)
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Position) error); ok {
+		r0 = rf(ctx, position)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -65,13 +65,13 @@ func (_m *ListenersRepository) CreateListenerTP(ctx context.Context, notify *mod
 	return r0
 }
 
-// RemoveListenerSL provides a mock function with given fields: notify
-func (_m *ListenersRepository) RemoveListenerSL(notify *model.Notification) error {
-	ret := _m.Called(notify)
+// CreateListenerTS provides a mock function with given fields: ctx, position
+func (_m *ListenersRepository) CreateListenerTS(ctx context.Context, position *model.Position) error {
+	ret := _m.Called(ctx, position)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(*model.Notification) error); ok {
-		r0 = rf(notify)
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Position) error); ok {
+		r0 = rf(ctx, position)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -79,13 +79,46 @@ func (_m *ListenersRepository) RemoveListenerSL(notify *model.Notification) erro
 	return r0
 }
 
-// RemoveListenerTP provides a mock function with given fields: notify
-func (_m *ListenersRepository) RemoveListenerTP(notify *model.Notification) error {
-	ret := _m.Called(notify)
+// RearmListeners provides a mock function with given fields: position
+func (_m *ListenersRepository) RearmListeners(position *model.Position) {
+	_m.Called(position)
+}
+
+// RemoveListenerSL provides a mock function with given fields: position
+func (_m *ListenersRepository) RemoveListenerSL(position *model.Position) error {
+	ret := _m.Called(position)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*model.Position) error); ok {
+		r0 = rf(position)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RemoveListenerTP provides a mock function with given fields: position
+func (_m *ListenersRepository) RemoveListenerTP(position *model.Position) error {
+	ret := _m.Called(position)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(*model.Notification) error); ok {
-		r0 = rf(notify)
+	if rf, ok := ret.Get(0).(func(*model.Position) error); ok {
+		r0 = rf(position)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RemoveListenerTS provides a mock function with given fields: position
+func (_m *ListenersRepository) RemoveListenerTS(position *model.Position) error {
+	ret := _m.Called(position)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*model.Position) error); ok {
+		r0 = rf(position)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -98,6 +131,29 @@ func (_m *ListenersRepository) SendPrices(prices []*model.Price) {
 	_m.Called(prices)
 }
 
+// TrailingLevel provides a mock function with given fields: ctx
+func (_m *ListenersRepository) TrailingLevel(ctx context.Context) (*model.Position, error) {
+	ret := _m.Called(ctx)
+
+	var r0 *model.Position
+	if rf, ok := ret.Get(0).(func(context.Context) *model.Position); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Position)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 type mockConstructorTestingTNewListenersRepository interface {
 	mock.TestingT
 	Cleanup(func())
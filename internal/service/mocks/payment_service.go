@@ -13,13 +13,13 @@ type PaymentService struct {
 	mock.Mock
 }
 
-// DecreaseAmount provides a mock function with given fields: ctx, accountID, amount
-func (_m *PaymentService) DecreaseAmount(ctx context.Context, accountID string, amount float64) error {
-	ret := _m.Called(ctx, accountID, amount)
+// DecreaseAmount provides a mock function with given fields: ctx, accountID, amount, idempotencyKey
+func (_m *PaymentService) DecreaseAmount(ctx context.Context, accountID string, amount float64, idempotencyKey string) error {
+	ret := _m.Called(ctx, accountID, amount, idempotencyKey)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, float64) error); ok {
-		r0 = rf(ctx, accountID, amount)
+	if rf, ok := ret.Get(0).(func(context.Context, string, float64, string) error); ok {
+		r0 = rf(ctx, accountID, amount, idempotencyKey)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -48,13 +48,34 @@ func (_m *PaymentService) GetAccountID(ctx context.Context, userID string) (stri
 	return r0, r1
 }
 
-// IncreaseAmount provides a mock function with given fields: ctx, accountID, amount
-func (_m *PaymentService) IncreaseAmount(ctx context.Context, accountID string, amount float64) error {
-	ret := _m.Called(ctx, accountID, amount)
+// GetBalance provides a mock function with given fields: ctx, userID
+func (_m *PaymentService) GetBalance(ctx context.Context, userID string) (float64, error) {
+	ret := _m.Called(ctx, userID)
+
+	var r0 float64
+	if rf, ok := ret.Get(0).(func(context.Context, string) float64); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(float64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IncreaseAmount provides a mock function with given fields: ctx, accountID, amount, idempotencyKey
+func (_m *PaymentService) IncreaseAmount(ctx context.Context, accountID string, amount float64, idempotencyKey string) error {
+	ret := _m.Called(ctx, accountID, amount, idempotencyKey)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, float64) error); ok {
-		r0 = rf(ctx, accountID, amount)
+	if rf, ok := ret.Get(0).(func(context.Context, string, float64, string) error); ok {
+		r0 = rf(ctx, accountID, amount, idempotencyKey)
 	} else {
 		r0 = ret.Error(0)
 	}
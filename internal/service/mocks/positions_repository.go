@@ -4,11 +4,10 @@ package mocks
 
 import (
 	context "context"
+	time "time"
 
 	model "github.com/OVantsevich/Trading-Service/internal/model"
 	mock "github.com/stretchr/testify/mock"
-
-	time "time"
 )
 
 // PositionsRepository is an autogenerated mock type for the PositionsRepository type
@@ -16,13 +15,32 @@ type PositionsRepository struct {
 	mock.Mock
 }
 
-// ClosePosition provides a mock function with given fields: ctx, positionID, closed, updated
-func (_m *PositionsRepository) ClosePosition(ctx context.Context, positionID string, closed int64, updated time.Time) (*model.Position, error) {
-	ret := _m.Called(ctx, positionID, closed, updated)
+// AckNotification provides a mock function with given fields: notify
+func (_m *PositionsRepository) AckNotification(notify *model.Notification) {
+	_m.Called(notify)
+}
+
+// AddFinancing provides a mock function with given fields: ctx, positionID, amount, updated
+func (_m *PositionsRepository) AddFinancing(ctx context.Context, positionID string, amount float64, updated time.Time) error {
+	ret := _m.Called(ctx, positionID, amount, updated)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, float64, time.Time) error); ok {
+		r0 = rf(ctx, positionID, amount, updated)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ClosePosition provides a mock function with given fields: ctx, position
+func (_m *PositionsRepository) ClosePosition(ctx context.Context, position *model.Position) (*model.Position, error) {
+	ret := _m.Called(ctx, position)
 
 	var r0 *model.Position
-	if rf, ok := ret.Get(0).(func(context.Context, string, int64, time.Time) *model.Position); ok {
-		r0 = rf(ctx, positionID, closed, updated)
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Position) *model.Position); ok {
+		r0 = rf(ctx, position)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*model.Position)
@@ -30,8 +48,8 @@ func (_m *PositionsRepository) ClosePosition(ctx context.Context, positionID str
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, string, int64, time.Time) error); ok {
-		r1 = rf(ctx, positionID, closed, updated)
+	if rf, ok := ret.Get(1).(func(context.Context, *model.Position) error); ok {
+		r1 = rf(ctx, position)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -39,6 +57,34 @@ func (_m *PositionsRepository) ClosePosition(ctx context.Context, positionID str
 	return r0, r1
 }
 
+// ConfirmPosition provides a mock function with given fields: ctx, positionID, updated
+func (_m *PositionsRepository) ConfirmPosition(ctx context.Context, positionID string, updated time.Time) error {
+	ret := _m.Called(ctx, positionID, updated)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time) error); ok {
+		r0 = rf(ctx, positionID, updated)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateFill provides a mock function with given fields: ctx, fill
+func (_m *PositionsRepository) CreateFill(ctx context.Context, fill *model.Fill) error {
+	ret := _m.Called(ctx, fill)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Fill) error); ok {
+		r0 = rf(ctx, fill)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // CreatePosition provides a mock function with given fields: ctx, position
 func (_m *PositionsRepository) CreatePosition(ctx context.Context, position *model.Position) (*model.Position, error) {
 	ret := _m.Called(ctx, position)
@@ -85,6 +131,29 @@ func (_m *PositionsRepository) GetNotification(ctx context.Context) (*model.Noti
 	return r0, r1
 }
 
+// GetOpenPositions provides a mock function with given fields: ctx
+func (_m *PositionsRepository) GetOpenPositions(ctx context.Context) ([]*model.Position, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []*model.Position
+	if rf, ok := ret.Get(0).(func(context.Context) []*model.Position); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Position)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetPositionByID provides a mock function with given fields: ctx, positionID
 func (_m *PositionsRepository) GetPositionByID(ctx context.Context, positionID string) (*model.Position, error) {
 	ret := _m.Called(ctx, positionID)
@@ -131,6 +200,99 @@ func (_m *PositionsRepository) GetUserPositions(ctx context.Context, userID stri
 	return r0, r1
 }
 
+// ListPositions provides a mock function with given fields: ctx, filter
+func (_m *PositionsRepository) ListPositions(ctx context.Context, filter *model.PositionFilter) ([]*model.Position, string, error) {
+	ret := _m.Called(ctx, filter)
+
+	var r0 []*model.Position
+	if rf, ok := ret.Get(0).(func(context.Context, *model.PositionFilter) []*model.Position); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Position)
+		}
+	}
+
+	var r1 string
+	if rf, ok := ret.Get(1).(func(context.Context, *model.PositionFilter) string); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, *model.PositionFilter) error); ok {
+		r2 = rf(ctx, filter)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// RestoreClosing provides a mock function with given fields: ctx, updated
+func (_m *PositionsRepository) RestoreClosing(ctx context.Context, updated time.Time) error {
+	ret := _m.Called(ctx, updated)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) error); ok {
+		r0 = rf(ctx, updated)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RestoreStatus provides a mock function with given fields: ctx, positionID, updated
+func (_m *PositionsRepository) RestoreStatus(ctx context.Context, positionID string, updated time.Time) error {
+	ret := _m.Called(ctx, positionID, updated)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time) error); ok {
+		r0 = rf(ctx, positionID, updated)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SetLiquidationReason provides a mock function with given fields: ctx, positionID, reason, updated
+func (_m *PositionsRepository) SetLiquidationReason(ctx context.Context, positionID string, reason string, updated time.Time) error {
+	ret := _m.Called(ctx, positionID, reason, updated)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, time.Time) error); ok {
+		r0 = rf(ctx, positionID, reason, updated)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SetStatus provides a mock function with given fields: ctx, positionID, status, updated
+func (_m *PositionsRepository) SetStatus(ctx context.Context, positionID string, status string, updated time.Time) (string, error) {
+	ret := _m.Called(ctx, positionID, status, updated)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, time.Time) string); ok {
+		r0 = rf(ctx, positionID, status, updated)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, time.Time) error); ok {
+		r1 = rf(ctx, positionID, status, updated)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // SetStopLoss provides a mock function with given fields: ctx, positionID, stopLoss, updated
 func (_m *PositionsRepository) SetStopLoss(ctx context.Context, positionID string, stopLoss float64, updated time.Time) error {
 	ret := _m.Called(ctx, positionID, stopLoss, updated)
@@ -159,6 +321,34 @@ func (_m *PositionsRepository) SetTakeProfit(ctx context.Context, positionID str
 	return r0
 }
 
+// SetTrailingLevel provides a mock function with given fields: ctx, positionID, level, updated
+func (_m *PositionsRepository) SetTrailingLevel(ctx context.Context, positionID string, level float64, updated time.Time) error {
+	ret := _m.Called(ctx, positionID, level, updated)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, float64, time.Time) error); ok {
+		r0 = rf(ctx, positionID, level, updated)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SetTrailingStop provides a mock function with given fields: ctx, positionID, trailingStop, percentage, updated
+func (_m *PositionsRepository) SetTrailingStop(ctx context.Context, positionID string, trailingStop float64, percentage bool, updated time.Time) error {
+	ret := _m.Called(ctx, positionID, trailingStop, percentage, updated)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, float64, bool, time.Time) error); ok {
+		r0 = rf(ctx, positionID, trailingStop, percentage, updated)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // UpdatePosition provides a mock function with given fields: ctx, position
 func (_m *PositionsRepository) UpdatePosition(ctx context.Context, position *model.Position) error {
 	ret := _m.Called(ctx, position)
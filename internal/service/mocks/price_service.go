@@ -60,6 +60,20 @@ func (_m *PriceService) GetPrices() ([]*model.Price, error) {
 	return r0, r1
 }
 
+// Stale provides a mock function with given fields:
+func (_m *PriceService) Stale() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
 // UpdateSubscription provides a mock function with given fields: names
 func (_m *PriceService) UpdateSubscription(names []string) error {
 	ret := _m.Called(names)
@@ -0,0 +1,161 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/OVantsevich/Trading-Service/internal/model"
+
+	"github.com/google/uuid"
+)
+
+// orderPlaced
+const orderPlaced = "order_placed"
+
+// orderClosed
+const orderClosed = "order_closed"
+
+// OrdersRepository limit orders repository
+//
+//go:generate mockery --name=OrdersRepository --case=underscore --output=./mocks
+type OrdersRepository interface {
+	CreateOrder(ctx context.Context, order *model.Order) (*model.Order, error)
+	GetUserOrders(ctx context.Context, userID string) ([]*model.Order, error)
+	GetPendingOrders(ctx context.Context) ([]*model.Order, error)
+	CancelOrder(ctx context.Context, id string, updated time.Time) error
+	FillOrder(ctx context.Context, id string, updated time.Time) (*model.Order, error)
+	SetOrderPosition(ctx context.Context, id, positionID string, updated time.Time) error
+	FailOrder(ctx context.Context, id string, updated time.Time) error
+}
+
+// OrdersListenersRepository pool of channels for limit orders goroutines
+//
+//go:generate mockery --name=OrdersListenersRepository --case=underscore --output=./mocks
+type OrdersListenersRepository interface {
+	CreateListener(ctx context.Context, order *model.Order) error
+	RemoveListener(order *model.Order) error
+
+	SendPrices(prices []*model.Price)
+	FillOrder(ctx context.Context) (*model.Order, error)
+}
+
+// PlaceLimitOrder place order which opens position when the limit price is reached
+func (t *Trading) PlaceLimitOrder(ctx context.Context, order *model.Order) (*model.Order, error) {
+	if order.Amount <= 0 || order.Price <= 0 {
+		return nil, fmt.Errorf("trading - PlaceLimitOrder: %w", model.ErrInvalidOrder)
+	}
+	order.ID = uuid.New().String()
+	order.Status = model.OrderPending
+	order.Created = time.Now()
+	order.Updated = order.Created
+	ord, err := t.ordersRepository.CreateOrder(ctx, order)
+	if err != nil {
+		return nil, fmt.Errorf("trading - PlaceLimitOrder - CreateOrder: %w", err)
+	}
+	return ord, nil
+}
+
+// CancelOrder cancel pending order
+func (t *Trading) CancelOrder(ctx context.Context, orderID string, updated time.Time) error {
+	err := t.ordersRepository.CancelOrder(ctx, orderID, updated)
+	if err != nil {
+		return fmt.Errorf("trading - CancelOrder - CancelOrder: %w", err)
+	}
+	return nil
+}
+
+// GetUserOrders get orders by user id
+func (t *Trading) GetUserOrders(ctx context.Context, userID string) ([]*model.Order, error) {
+	orders, err := t.ordersRepository.GetUserOrders(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("trading - GetUserOrders - GetUserOrders: %w", err)
+	}
+	return orders, nil
+}
+
+// fillOrder open position for order which limit price was reached, order is filled within the same transaction,
+// so it stays pending and is listened again if price moved back past the limit
+func (t *Trading) fillOrder(ctx context.Context, order *model.Order) error {
+	var filled, reached bool
+	err := t.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		response, trxErr := t.priceService.GetCurrentPrices(ctx, []string{order.Name})
+		if trxErr != nil {
+			return fmt.Errorf("trading - fillOrder - GetCurrentPrices: %w", trxErr)
+		}
+		price, ok := response[order.Name]
+		if !ok {
+			return fmt.Errorf("trading - fillOrder: no price of %s", order.Name)
+		}
+		if !order.Reached(price) {
+			return nil
+		}
+		reached = true
+
+		_, trxErr = t.ordersRepository.FillOrder(ctx, order.ID, time.Now())
+		if trxErr != nil {
+			return fmt.Errorf("trading - fillOrder - FillOrder: %w", trxErr)
+		}
+		filled = true
+
+		pos, trxErr := t.openPosition(ctx, &model.Position{
+			User:          order.User,
+			Name:          order.Name,
+			Amount:        order.Amount,
+			ShortPosition: order.ShortPosition,
+			Created:       time.Now(),
+			Updated:       time.Now(),
+		}, price)
+		if trxErr != nil {
+			return fmt.Errorf("trading - fillOrder - openPosition: %w", trxErr)
+		}
+
+		trxErr = t.ordersRepository.SetOrderPosition(ctx, order.ID, pos.ID, time.Now())
+		if trxErr != nil {
+			return fmt.Errorf("trading - fillOrder - SetOrderPosition: %w", trxErr)
+		}
+		return nil
+	})
+	if err != nil && filled {
+		if failErr := t.ordersRepository.FailOrder(ctx, order.ID, time.Now()); failErr != nil {
+			return fmt.Errorf("trading - fillOrder - FailOrder: %w", failErr)
+		}
+		return err
+	}
+	if !reached {
+		if rearmErr := t.rearmOrder(ctx, order); rearmErr != nil {
+			return rearmErr
+		}
+	}
+	return err
+}
+
+// rearmOrder listen again for order which listener has already fired, order removed meanwhile isn't listened
+func (t *Trading) rearmOrder(ctx context.Context, order *model.Order) error {
+	if err := t.ordersListeners.RemoveListener(order); err != nil {
+		return nil
+	}
+	err := t.ordersListeners.CreateListener(ctx, order)
+	if err != nil {
+		return fmt.Errorf("trading - rearmOrder - CreateListener: %w", err)
+	}
+	return nil
+}
+
+func fillOrderListener(ctx context.Context, t *Trading, errChan chan error) {
+	for {
+		select {
+		case <-ctx.Done():
+		default:
+			order, err := t.ordersListeners.FillOrder(ctx)
+			if err != nil {
+				errChan <- fmt.Errorf("trading - fillOrderListener - FillOrder: %w", err)
+				continue
+			}
+			err = t.fillOrder(ctx, order)
+			if err != nil {
+				errChan <- err
+			}
+		}
+	}
+}
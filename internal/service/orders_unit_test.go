@@ -0,0 +1,69 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/OVantsevich/Trading-Service/internal/model"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTrading_PlaceLimitOrder_Invalid(t *testing.T) {
+	trading, _ := newMockedTrading(t)
+
+	_, err := trading.PlaceLimitOrder(context.Background(), &model.Order{Name: "order", Amount: 0, Price: 100})
+	require.ErrorIs(t, err, model.ErrInvalidOrder)
+	_, err = trading.PlaceLimitOrder(context.Background(), &model.Order{Name: "order", Amount: 1, Price: -1})
+	require.ErrorIs(t, err, model.ErrInvalidOrder)
+}
+
+func TestTrading_FillOrder(t *testing.T) {
+	trading, m := newMockedTrading(t)
+	order := &model.Order{ID: uuid.NewString(), User: uuid.NewString(), Name: "order", Amount: 2, Price: 100}
+
+	m.prices.On("GetCurrentPrices", mock.Anything, []string{order.Name}).Return(
+		map[string]*model.Price{order.Name: {Name: order.Name, PurchasePrice: 99, SellingPrice: 98}}, nil)
+	m.orders.On("FillOrder", mock.Anything, order.ID, mock.Anything).Return(order, nil)
+	m.commissions.On("GetCommissionSchedule", mock.Anything, order.User, order.Name).Return(nil, nil)
+	m.positions.On("CreatePosition", mock.Anything, mock.MatchedBy(func(pos *model.Position) bool {
+		return pos.PurchasePrice == 99 && pos.Amount == 2
+	})).Return(func(_ context.Context, pos *model.Position) *model.Position { return pos }, nil)
+	m.outbox.On("CreatePaymentCommand", mock.Anything, payment(model.PaymentOpen, -198)).Return(nil)
+	m.orders.On("SetOrderPosition", mock.Anything, order.ID, mock.AnythingOfType("string"), mock.Anything).Return(nil)
+
+	err := trading.fillOrder(context.Background(), order)
+	require.NoError(t, err)
+}
+
+func TestTrading_FillOrder_PriceMovedBack(t *testing.T) {
+	trading, m := newMockedTrading(t)
+	order := &model.Order{ID: uuid.NewString(), User: uuid.NewString(), Name: "order", Amount: 2, Price: 100, ShortPosition: true}
+
+	m.prices.On("GetCurrentPrices", mock.Anything, []string{order.Name}).Return(
+		map[string]*model.Price{order.Name: {Name: order.Name, PurchasePrice: 99, SellingPrice: 98}}, nil)
+	m.ordersLis.On("RemoveListener", order).Return(nil)
+	m.ordersLis.On("CreateListener", mock.Anything, order).Return(nil)
+
+	err := trading.fillOrder(context.Background(), order)
+	require.NoError(t, err)
+	m.orders.AssertNotCalled(t, "FillOrder", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestTrading_FillOrder_Failed(t *testing.T) {
+	trading, m := newMockedTrading(t)
+	order := &model.Order{ID: uuid.NewString(), User: uuid.NewString(), Name: "order", Amount: 2, Price: 100}
+
+	m.prices.On("GetCurrentPrices", mock.Anything, []string{order.Name}).Return(
+		map[string]*model.Price{order.Name: {Name: order.Name, PurchasePrice: 100, SellingPrice: 99}}, nil)
+	m.orders.On("FillOrder", mock.Anything, order.ID, mock.Anything).Return(order, nil)
+	m.commissions.On("GetCommissionSchedule", mock.Anything, order.User, order.Name).Return(nil, errors.New("commissions unavailable"))
+	m.orders.On("FailOrder", mock.Anything, order.ID, mock.Anything).Return(nil)
+
+	err := trading.fillOrder(context.Background(), order)
+	require.Error(t, err)
+	m.positions.AssertNotCalled(t, "CreatePosition", mock.Anything, mock.Anything)
+}
@@ -0,0 +1,328 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/OVantsevich/Trading-Service/internal/model"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+)
+
+// paymentBackoffBase delay before the first retry of failed payment command
+const paymentBackoffBase = time.Second
+
+// paymentCallTimeout max duration of payment service call
+const paymentCallTimeout = 30 * time.Second
+
+// paymentLease how long command stays in flight before it is executed again, must exceed paymentCallTimeout
+const paymentLease = 2 * paymentCallTimeout
+
+// PaymentOutboxRepository outbox of payment commands
+//
+//go:generate mockery --name=PaymentOutboxRepository --case=underscore --output=./mocks
+type PaymentOutboxRepository interface {
+	CreatePaymentCommand(ctx context.Context, command *model.PaymentCommand) error
+	GetDuePaymentCommands(ctx context.Context, now time.Time, limit int) ([]*model.PaymentCommand, error)
+	GetPositionPaymentCommands(ctx context.Context, positionID string) ([]*model.PaymentCommand, error)
+	UpdatePaymentCommand(ctx context.Context, command *model.PaymentCommand) error
+	CancelPaymentCommands(ctx context.Context, positionID string, updated time.Time) error
+}
+
+// enqueuePayment store payment command within the transaction of position change,
+// signed amount is credited to the user account if positive and debited otherwise
+func (t *Trading) enqueuePayment(ctx context.Context, pos *model.Position, amount float64, reason string, snapshot *model.Position) error {
+	now := time.Now()
+	command := &model.PaymentCommand{
+		ID:          uuid.New().String(),
+		PositionID:  pos.ID,
+		User:        pos.User,
+		Reason:      reason,
+		Snapshot:    snapshot,
+		Status:      model.PaymentPending,
+		NextAttempt: now,
+		Created:     now,
+		Updated:     now,
+	}
+	setPaymentAmount(command, amount)
+	err := t.paymentOutbox.CreatePaymentCommand(ctx, command)
+	if err != nil {
+		return fmt.Errorf("trading - enqueuePayment - CreatePaymentCommand: %w", err)
+	}
+	return nil
+}
+
+// setPaymentAmount set operation and amount of command from signed amount
+func setPaymentAmount(command *model.PaymentCommand, amount float64) {
+	command.Operation = model.PaymentIncrease
+	if amount < 0 {
+		command.Operation = model.PaymentDecrease
+		amount = -amount
+	}
+	command.Amount = amount
+}
+
+// paymentAmount signed amount of command
+func paymentAmount(command *model.PaymentCommand) float64 {
+	if command.Operation == model.PaymentDecrease {
+		return -command.Amount
+	}
+	return command.Amount
+}
+
+// paymentBackoff exponential delay before next attempt
+func (t *Trading) paymentBackoff(attempts int) time.Duration {
+	delay := paymentBackoffBase
+	for i := 1; i < attempts && delay < t.paymentBackoffMax; i++ {
+		delay *= 2
+	}
+	if delay > t.paymentBackoffMax {
+		return t.paymentBackoffMax
+	}
+	return delay
+}
+
+// processPayment execute the oldest due command, returns false if there is nothing to execute;
+// command is claimed and committed before payment service is called and its result is recorded in another transaction,
+// so no lock is held across the call and a failed commit can't execute it again,
+// command of worker which died during the call is executed again when its lease expires;
+// command id is the idempotency key of the call, so payment service applies repeated execution only once
+func (t *Trading) processPayment(ctx context.Context) (bool, error) {
+	command, err := t.claimPayment(ctx)
+	if err != nil || command == nil {
+		return command != nil, err
+	}
+
+	callCtx, cancel := context.WithTimeout(ctx, paymentCallTimeout)
+	callErr := t.executePayment(callCtx, command)
+	cancel()
+
+	// result is recorded even if worker is stopping, otherwise executed command would be executed again
+	err = t.recordPayment(withoutCancel{ctx}, command, callErr)
+	if err != nil {
+		return true, err
+	}
+	if callErr != nil {
+		return true, nil
+	}
+
+	err = t.refreshBalance(ctx, command.User)
+	if err != nil {
+		return true, fmt.Errorf("trading - processPayment - refreshBalance: %w", err)
+	}
+	return true, nil
+}
+
+// claimPayment mark the oldest due command in flight until its lease expires
+func (t *Trading) claimPayment(ctx context.Context) (*model.PaymentCommand, error) {
+	var command *model.PaymentCommand
+	err := t.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		commands, trxErr := t.paymentOutbox.GetDuePaymentCommands(ctx, time.Now(), 1)
+		if trxErr != nil {
+			return fmt.Errorf("trading - claimPayment - GetDuePaymentCommands: %w", trxErr)
+		}
+		if len(commands) == 0 {
+			return nil
+		}
+
+		claimed := commands[0]
+		claimed.Attempts++
+		claimed.Status = model.PaymentInFlight
+		claimed.Updated = time.Now()
+		claimed.NextAttempt = claimed.Updated.Add(paymentLease)
+		trxErr = t.paymentOutbox.UpdatePaymentCommand(ctx, claimed)
+		if trxErr != nil {
+			return fmt.Errorf("trading - claimPayment - UpdatePaymentCommand: %w", trxErr)
+		}
+		command = claimed
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return command, nil
+}
+
+// recordPayment store result of executed command: executed opening confirms position, failed command is retried with backoff,
+// permanently failed opening or increase is compensated, permanently failed settlement, financing
+// or increase which can't be compensated is left for reconciliation
+func (t *Trading) recordPayment(ctx context.Context, command *model.PaymentCommand, callErr error) error {
+	return t.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		command.Updated = time.Now()
+		switch {
+		case callErr == nil:
+			command.Status = model.PaymentDone
+			command.LastError = ""
+			if command.Reason == model.PaymentOpen {
+				err := t.confirmPosition(ctx, command)
+				if err != nil {
+					return fmt.Errorf("trading - recordPayment - confirmPosition: %w", err)
+				}
+			}
+		case command.Attempts < t.paymentMaxAttempts:
+			command.Status = model.PaymentPending
+			command.LastError = callErr.Error()
+			command.NextAttempt = command.Updated.Add(t.paymentBackoff(command.Attempts))
+		case command.Reason == model.PaymentSettle || command.Reason == model.PaymentFinancing:
+			command.LastError = callErr.Error()
+			t.reconcilePayment(command)
+		default:
+			command.LastError = callErr.Error()
+			compensated, err := t.compensatePayment(ctx, command)
+			if err != nil {
+				return fmt.Errorf("trading - recordPayment - compensatePayment: %w", err)
+			}
+			if !compensated {
+				t.reconcilePayment(command)
+				break
+			}
+			command.Status = model.PaymentFailed
+			logrus.Errorf("trading - recordPayment: payment command %s of position %s failed: %s", command.ID, command.PositionID, command.LastError)
+		}
+
+		err := t.paymentOutbox.UpdatePaymentCommand(ctx, command)
+		if err != nil {
+			return fmt.Errorf("trading - recordPayment - UpdatePaymentCommand: %w", err)
+		}
+		return nil
+	})
+}
+
+// reconcilePayment leave permanently failed command to be retried once per reconciliation interval
+func (t *Trading) reconcilePayment(command *model.PaymentCommand) {
+	command.Status = model.PaymentReconcile
+	command.NextAttempt = command.Updated.Add(t.paymentReconcileInterval)
+	logrus.Errorf("trading - reconcilePayment: payment command %s of position %s is left for reconciliation: %s",
+		command.ID, command.PositionID, command.LastError)
+}
+
+// confirmPosition open position which initial margin is debited, position closed meanwhile is left as is
+func (t *Trading) confirmPosition(ctx context.Context, command *model.PaymentCommand) error {
+	err := t.positionsRepository.ConfirmPosition(ctx, command.PositionID, command.Updated)
+	if err != nil && !errors.Is(err, model.ErrPositionTransition) && !closeRejected(err) {
+		return fmt.Errorf("trading - confirmPosition - ConfirmPosition: %w", err)
+	}
+	return nil
+}
+
+// executePayment call payment service with command id as idempotency key
+func (t *Trading) executePayment(ctx context.Context, command *model.PaymentCommand) error {
+	accountID, err := t.paymentService.GetAccountID(ctx, command.User)
+	if err != nil {
+		return fmt.Errorf("trading - executePayment - GetAccountID: %w", err)
+	}
+
+	if command.Operation == model.PaymentIncrease {
+		err = t.paymentService.IncreaseAmount(ctx, accountID, command.Amount, command.ID)
+		if err != nil {
+			return fmt.Errorf("trading - executePayment - IncreaseAmount: %w", err)
+		}
+		return nil
+	}
+
+	err = t.paymentService.DecreaseAmount(ctx, accountID, command.Amount, command.ID)
+	if err != nil {
+		return fmt.Errorf("trading - executePayment - DecreaseAmount: %w", err)
+	}
+	return nil
+}
+
+// compensatePayment undo position change which payment failed permanently, returns false if change can't be undone:
+// unpaid position is closed at purchase price without pnl and fees and its later commands are canceled,
+// unpaid increase is netted into the next command of position or reverted if position wasn't changed since the increase
+func (t *Trading) compensatePayment(ctx context.Context, command *model.PaymentCommand) (bool, error) {
+	switch command.Reason {
+	case model.PaymentOpen:
+		err := t.paymentOutbox.CancelPaymentCommands(ctx, command.PositionID, command.Updated)
+		if err != nil {
+			return false, fmt.Errorf("trading - compensatePayment - CancelPaymentCommands: %w", err)
+		}
+		pos, err := t.positionsRepository.GetPositionByID(ctx, command.PositionID)
+		if err != nil {
+			return false, fmt.Errorf("trading - compensatePayment - GetPositionByID: %w", err)
+		}
+		if checkActive(pos) != nil {
+			return true, nil
+		}
+		err = t.positionsRepository.SetLiquidationReason(ctx, pos.ID, model.LiquidationPaymentFailed, command.Updated)
+		if err != nil {
+			return false, fmt.Errorf("trading - compensatePayment - SetLiquidationReason: %w", err)
+		}
+		_, err = t.positionsRepository.ClosePosition(ctx, &model.Position{
+			ID:           pos.ID,
+			Status:       model.PositionFailed,
+			Closed:       command.Updated.Unix(),
+			SellingPrice: pos.PurchasePrice,
+			CloseReason:  model.ClosePaymentFailed,
+			RealizedPNL:  -pos.RealizedPNL,
+			Fees:         -pos.Fees,
+			Updated:      command.Updated,
+		})
+		if err != nil {
+			return false, fmt.Errorf("trading - compensatePayment - ClosePosition: %w", err)
+		}
+	case model.PaymentScale:
+		pending, err := t.paymentOutbox.GetPositionPaymentCommands(ctx, command.PositionID)
+		if err != nil {
+			return false, fmt.Errorf("trading - compensatePayment - GetPositionPaymentCommands: %w", err)
+		}
+		for _, next := range pending {
+			if next.ID == command.ID {
+				continue
+			}
+			setPaymentAmount(next, paymentAmount(next)+paymentAmount(command))
+			next.Updated = command.Updated
+			err = t.paymentOutbox.UpdatePaymentCommand(ctx, next)
+			if err != nil {
+				return false, fmt.Errorf("trading - compensatePayment - UpdatePaymentCommand: %w", err)
+			}
+			return true, nil
+		}
+		if command.Snapshot == nil {
+			return false, nil
+		}
+		pos, err := t.positionsRepository.GetPositionByID(ctx, command.PositionID)
+		if err != nil {
+			return false, fmt.Errorf("trading - compensatePayment - GetPositionByID: %w", err)
+		}
+		if checkActive(pos) != nil || !sameUpdate(pos.Updated, command.Snapshot.Updated) {
+			return false, nil
+		}
+		command.Snapshot.Updated = command.Updated
+		err = t.positionsRepository.UpdatePosition(ctx, command.Snapshot)
+		if err != nil {
+			return false, fmt.Errorf("trading - compensatePayment - UpdatePosition: %w", err)
+		}
+	}
+	return true, nil
+}
+
+// sameUpdate compare update times with precision of timestamps stored by postgres
+func sameUpdate(a, b time.Time) bool {
+	return a.Sub(b).Abs() < time.Microsecond
+}
+
+func paymentOutboxListener(ctx context.Context, t *Trading, errChan chan error) {
+	ticker := time.NewTicker(t.paymentPollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			for {
+				processed, err := t.processPayment(ctx)
+				if err != nil {
+					errChan <- err
+					break
+				}
+				if !processed {
+					break
+				}
+			}
+		}
+	}
+}
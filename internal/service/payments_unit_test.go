@@ -0,0 +1,153 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/OVantsevich/Trading-Service/internal/model"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// recordStatuses collect statuses of command stored by outbox
+func recordStatuses(m *tradingMocks, statuses *[]string) {
+	m.outbox.On("UpdatePaymentCommand", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		*statuses = append(*statuses, args.Get(1).(*model.PaymentCommand).Status)
+	}).Return(nil)
+}
+
+func TestTrading_ProcessPayment_ClaimedBeforeCall(t *testing.T) {
+	trading, m := newMockedTrading(t)
+	command := &model.PaymentCommand{ID: uuid.NewString(), PositionID: uuid.NewString(), User: uuid.NewString(),
+		Operation: model.PaymentDecrease, Amount: 100, Reason: model.PaymentOpen, Status: model.PaymentPending}
+	var statuses []string
+
+	m.outbox.On("GetDuePaymentCommands", mock.Anything, mock.Anything, 1).Return([]*model.PaymentCommand{command}, nil)
+	recordStatuses(m, &statuses)
+	m.payments.On("GetAccountID", mock.Anything, command.User).Return("account", nil)
+	m.payments.On("DecreaseAmount", mock.Anything, "account", 100.0, command.ID).Run(func(args mock.Arguments) {
+		require.Equal(t, []string{model.PaymentInFlight}, statuses)
+		require.True(t, command.NextAttempt.After(time.Now().Add(paymentCallTimeout)))
+	}).Return(nil)
+	m.positions.On("ConfirmPosition", mock.Anything, command.PositionID, mock.Anything).Return(nil)
+	m.payments.On("GetBalance", mock.Anything, command.User).Return(900.0, nil)
+	m.pnl.On("UpdateBalance", command.User, 900.0).Return(nil)
+
+	processed, err := trading.processPayment(context.Background())
+	require.NoError(t, err)
+	require.True(t, processed)
+	require.Equal(t, []string{model.PaymentInFlight, model.PaymentDone}, statuses)
+	require.Equal(t, 1, command.Attempts)
+}
+
+func TestTrading_ProcessPayment_Retry(t *testing.T) {
+	trading, m := newMockedTrading(t)
+	command := &model.PaymentCommand{ID: uuid.NewString(), PositionID: uuid.NewString(), User: uuid.NewString(),
+		Operation: model.PaymentIncrease, Amount: 100, Reason: model.PaymentSettle, Status: model.PaymentPending}
+	var statuses []string
+
+	m.outbox.On("GetDuePaymentCommands", mock.Anything, mock.Anything, 1).Return([]*model.PaymentCommand{command}, nil)
+	recordStatuses(m, &statuses)
+	m.payments.On("GetAccountID", mock.Anything, command.User).Return("", errors.New("unavailable"))
+
+	processed, err := trading.processPayment(context.Background())
+	require.NoError(t, err)
+	require.True(t, processed)
+	require.Equal(t, []string{model.PaymentInFlight, model.PaymentPending}, statuses)
+	require.WithinDuration(t, time.Now().Add(paymentBackoffBase), command.NextAttempt, time.Second)
+}
+
+func TestTrading_ProcessPayment_SettleReconciled(t *testing.T) {
+	trading, m := newMockedTrading(t)
+	command := &model.PaymentCommand{ID: uuid.NewString(), PositionID: uuid.NewString(), User: uuid.NewString(),
+		Operation: model.PaymentIncrease, Amount: 100, Reason: model.PaymentSettle, Status: model.PaymentPending, Attempts: 2}
+	var statuses []string
+
+	m.outbox.On("GetDuePaymentCommands", mock.Anything, mock.Anything, 1).Return([]*model.PaymentCommand{command}, nil)
+	recordStatuses(m, &statuses)
+	m.payments.On("GetAccountID", mock.Anything, command.User).Return("account", nil)
+	m.payments.On("IncreaseAmount", mock.Anything, "account", 100.0, command.ID).Return(errors.New("account blocked"))
+
+	_, err := trading.processPayment(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, []string{model.PaymentInFlight, model.PaymentReconcile}, statuses)
+	require.Contains(t, command.LastError, "account blocked")
+	require.WithinDuration(t, time.Now().Add(time.Hour), command.NextAttempt, time.Second)
+}
+
+func TestTrading_ProcessPayment_OpenCompensated(t *testing.T) {
+	trading, m := newMockedTrading(t)
+	position := &model.Position{ID: uuid.NewString(), User: uuid.NewString(), PurchasePrice: 100, Status: model.PositionClosed}
+	command := &model.PaymentCommand{ID: uuid.NewString(), PositionID: position.ID, User: position.User,
+		Operation: model.PaymentDecrease, Amount: 100, Reason: model.PaymentOpen, Status: model.PaymentPending, Attempts: 2}
+	var statuses []string
+
+	m.outbox.On("GetDuePaymentCommands", mock.Anything, mock.Anything, 1).Return([]*model.PaymentCommand{command}, nil)
+	recordStatuses(m, &statuses)
+	m.payments.On("GetAccountID", mock.Anything, command.User).Return("account", nil)
+	m.payments.On("DecreaseAmount", mock.Anything, "account", 100.0, command.ID).Return(errors.New("insufficient funds"))
+	m.outbox.On("CancelPaymentCommands", mock.Anything, position.ID, mock.Anything).Return(nil)
+	m.positions.On("GetPositionByID", mock.Anything, position.ID).Return(position, nil)
+
+	_, err := trading.processPayment(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, []string{model.PaymentInFlight, model.PaymentFailed}, statuses)
+	m.positions.AssertNotCalled(t, "ClosePosition", mock.Anything, mock.Anything)
+}
+
+func TestTrading_CompensatePayment_Scale(t *testing.T) {
+	t.Run("netted into next command", func(t *testing.T) {
+		trading, m := newMockedTrading(t)
+		command := &model.PaymentCommand{ID: uuid.NewString(), PositionID: uuid.NewString(), Operation: model.PaymentDecrease, Amount: 30,
+			Reason: model.PaymentScale, Updated: time.Now()}
+		next := &model.PaymentCommand{ID: uuid.NewString(), PositionID: command.PositionID, Operation: model.PaymentIncrease, Amount: 50,
+			Reason: model.PaymentSettle}
+
+		m.outbox.On("GetPositionPaymentCommands", mock.Anything, command.PositionID).Return([]*model.PaymentCommand{command, next}, nil)
+		m.outbox.On("UpdatePaymentCommand", mock.Anything, payment(model.PaymentSettle, 20)).Return(nil)
+
+		compensated, err := trading.compensatePayment(context.Background(), command)
+		require.NoError(t, err)
+		require.True(t, compensated)
+		m.positions.AssertNotCalled(t, "UpdatePosition", mock.Anything, mock.Anything)
+	})
+	t.Run("reverted to snapshot", func(t *testing.T) {
+		trading, m := newMockedTrading(t)
+		increased := time.Now().Add(-time.Minute)
+		snapshot := &model.Position{ID: uuid.NewString(), Amount: 5, PurchasePrice: 100, Updated: increased}
+		position := &model.Position{ID: snapshot.ID, Amount: 8, PurchasePrice: 100, Status: model.PositionOpen, Updated: increased}
+		command := &model.PaymentCommand{ID: uuid.NewString(), PositionID: snapshot.ID, Operation: model.PaymentDecrease, Amount: 30,
+			Reason: model.PaymentScale, Snapshot: snapshot, Updated: time.Now()}
+
+		m.outbox.On("GetPositionPaymentCommands", mock.Anything, command.PositionID).Return([]*model.PaymentCommand{command}, nil)
+		m.positions.On("GetPositionByID", mock.Anything, position.ID).Return(position, nil)
+		m.positions.On("UpdatePosition", mock.Anything, mock.MatchedBy(func(pos *model.Position) bool {
+			return pos.Amount == 5 && pos.Updated.Equal(command.Updated)
+		})).Return(nil)
+
+		compensated, err := trading.compensatePayment(context.Background(), command)
+		require.NoError(t, err)
+		require.True(t, compensated)
+	})
+	t.Run("changed since increase", func(t *testing.T) {
+		trading, m := newMockedTrading(t)
+		increased := time.Now().Add(-time.Minute)
+		snapshot := &model.Position{ID: uuid.NewString(), Amount: 5, PurchasePrice: 100, Updated: increased}
+		position := &model.Position{ID: snapshot.ID, Amount: 6, PurchasePrice: 100, Status: model.PositionOpen,
+			Updated: increased.Add(time.Second)}
+		command := &model.PaymentCommand{ID: uuid.NewString(), PositionID: snapshot.ID, Operation: model.PaymentDecrease, Amount: 30,
+			Reason: model.PaymentScale, Snapshot: snapshot, Updated: time.Now()}
+
+		m.outbox.On("GetPositionPaymentCommands", mock.Anything, command.PositionID).Return([]*model.PaymentCommand{command}, nil)
+		m.positions.On("GetPositionByID", mock.Anything, position.ID).Return(position, nil)
+
+		compensated, err := trading.compensatePayment(context.Background(), command)
+		require.NoError(t, err)
+		require.False(t, compensated)
+		m.positions.AssertNotCalled(t, "UpdatePosition", mock.Anything, mock.Anything)
+	})
+}
@@ -0,0 +1,81 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/OVantsevich/Trading-Service/internal/model"
+
+	"github.com/google/uuid"
+)
+
+// QuotesRepository firm quotes repository
+//
+//go:generate mockery --name=QuotesRepository --case=underscore --output=./mocks
+type QuotesRepository interface {
+	CreateQuote(ctx context.Context, quote *model.Quote) error
+	ExecuteQuote(ctx context.Context, id string, executed time.Time) (*model.Quote, error)
+	SetQuotePosition(ctx context.Context, id, positionID string) error
+}
+
+// RequestQuote lock current price of instrument for user until quote ttl passes
+func (t *Trading) RequestQuote(ctx context.Context, quote *model.Quote) (*model.Quote, error) {
+	if quote.Amount <= 0 {
+		return nil, fmt.Errorf("trading - RequestQuote: amount must be positive")
+	}
+	if t.priceService.Stale() {
+		return nil, fmt.Errorf("trading - RequestQuote: %w", model.ErrPricesStale)
+	}
+	response, err := t.priceService.GetCurrentPrices(ctx, []string{quote.Name})
+	if err != nil {
+		return nil, fmt.Errorf("trading - RequestQuote - GetCurrentPrices: %w", err)
+	}
+	price, ok := response[quote.Name]
+	if !ok {
+		return nil, fmt.Errorf("trading - RequestQuote: no price of %s", quote.Name)
+	}
+
+	quote.ID = uuid.New().String()
+	quote.PurchasePrice = price.PurchasePrice
+	quote.SellingPrice = price.SellingPrice
+	quote.Created = time.Now()
+	quote.Expires = quote.Created.Add(t.quoteTTL)
+	err = t.quotes.CreateQuote(ctx, quote)
+	if err != nil {
+		return nil, fmt.Errorf("trading - RequestQuote - CreateQuote: %w", err)
+	}
+	return quote, nil
+}
+
+// ExecuteQuote open position of quote at its locked price, expired or already executed quote is rejected
+func (t *Trading) ExecuteQuote(ctx context.Context, quoteID string) (*model.Position, error) {
+	var pos *model.Position
+	err := t.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		now := time.Now()
+		quote, trxErr := t.quotes.ExecuteQuote(ctx, quoteID, now)
+		if trxErr != nil {
+			return fmt.Errorf("trading - ExecuteQuote - ExecuteQuote: %w", trxErr)
+		}
+
+		pos, trxErr = t.openPosition(ctx, &model.Position{
+			User:          quote.User,
+			Name:          quote.Name,
+			Amount:        quote.Amount,
+			ShortPosition: quote.ShortPosition,
+			Created:       now,
+			Updated:       now,
+		}, quote.Price())
+		if trxErr != nil {
+			return fmt.Errorf("trading - ExecuteQuote - openPosition: %w", trxErr)
+		}
+
+		trxErr = t.quotes.SetQuotePosition(ctx, quote.ID, pos.ID)
+		if trxErr != nil {
+			return fmt.Errorf("trading - ExecuteQuote - SetQuotePosition: %w", trxErr)
+		}
+		return nil
+	})
+
+	return pos, err
+}
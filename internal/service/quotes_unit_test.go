@@ -0,0 +1,58 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/OVantsevich/Trading-Service/internal/model"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTrading_RequestQuote(t *testing.T) {
+	trading, m := newMockedTrading(t)
+	quote := &model.Quote{User: uuid.NewString(), Name: "quote", Amount: 2}
+
+	m.prices.On("GetCurrentPrices", mock.Anything, []string{quote.Name}).Return(
+		map[string]*model.Price{quote.Name: {Name: quote.Name, PurchasePrice: 101, SellingPrice: 100}}, nil)
+	m.quotes.On("CreateQuote", mock.Anything, mock.MatchedBy(func(q *model.Quote) bool {
+		return q.ID != "" && q.PurchasePrice == 101 && q.SellingPrice == 100 && q.Expires.Sub(q.Created) == 5*time.Second
+	})).Return(nil)
+
+	res, err := trading.RequestQuote(context.Background(), quote)
+	require.NoError(t, err)
+	require.Equal(t, 101.0, res.PurchasePrice)
+}
+
+func TestTrading_ExecuteQuote(t *testing.T) {
+	trading, m := newMockedTrading(t)
+	quote := &model.Quote{ID: uuid.NewString(), User: uuid.NewString(), Name: "quote", Amount: 2, PurchasePrice: 101, SellingPrice: 100}
+
+	m.quotes.On("ExecuteQuote", mock.Anything, quote.ID, mock.Anything).Return(quote, nil)
+	m.commissions.On("GetCommissionSchedule", mock.Anything, quote.User, quote.Name).Return(nil, nil)
+	m.positions.On("CreatePosition", mock.Anything, mock.MatchedBy(func(pos *model.Position) bool {
+		return pos.PurchasePrice == 101 && pos.Margin == 202 && pos.Status == model.PositionPending
+	})).Return(func(_ context.Context, pos *model.Position) *model.Position { return pos }, nil)
+	m.outbox.On("CreatePaymentCommand", mock.Anything, payment(model.PaymentOpen, -202)).Return(nil)
+	m.quotes.On("SetQuotePosition", mock.Anything, quote.ID, mock.AnythingOfType("string")).Return(nil)
+
+	pos, err := trading.ExecuteQuote(context.Background(), quote.ID)
+	require.NoError(t, err)
+	require.Equal(t, 101.0, pos.PurchasePrice)
+}
+
+func TestTrading_ExecuteQuote_Rejected(t *testing.T) {
+	trading, m := newMockedTrading(t)
+	expired, used := uuid.NewString(), uuid.NewString()
+
+	m.quotes.On("ExecuteQuote", mock.Anything, expired, mock.Anything).Return(nil, model.ErrQuoteExpired)
+	m.quotes.On("ExecuteQuote", mock.Anything, used, mock.Anything).Return(nil, model.ErrQuoteUsed)
+
+	_, err := trading.ExecuteQuote(context.Background(), expired)
+	require.ErrorIs(t, err, model.ErrQuoteExpired)
+	_, err = trading.ExecuteQuote(context.Background(), used)
+	require.ErrorIs(t, err, model.ErrQuoteUsed)
+}
@@ -0,0 +1,53 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/OVantsevich/Trading-Service/internal/model"
+)
+
+// RiskRepository risk limits of users
+//
+//go:generate mockery --name=RiskRepository --case=underscore --output=./mocks
+type RiskRepository interface {
+	GetRiskLimits(ctx context.Context, userID, name string) ([]*model.RiskLimits, error)
+	SetRiskLimits(ctx context.Context, limits *model.RiskLimits) error
+}
+
+// SetRiskLimits create or replace limits of user for all instruments or for one of them
+func (t *Trading) SetRiskLimits(ctx context.Context, limits *model.RiskLimits) error {
+	if limits.User == "" || limits.MaxOpenPositions < 0 || limits.MaxNotional < 0 || limits.MaxExposure < 0 || limits.MaxShortExposure < 0 {
+		return fmt.Errorf("trading - SetRiskLimits: %w", model.ErrInvalidRiskLimits)
+	}
+	limits.Updated = time.Now()
+	err := t.risk.SetRiskLimits(ctx, limits)
+	if err != nil {
+		return fmt.Errorf("trading - SetRiskLimits - SetRiskLimits: %w", err)
+	}
+	return nil
+}
+
+// checkRiskLimits reject adding notional to position if it breaches any limit of its user,
+// must be called within transaction which creates or updates position
+func (t *Trading) checkRiskLimits(ctx context.Context, position *model.Position, notional float64, opening bool) error {
+	limits, err := t.risk.GetRiskLimits(ctx, position.User, position.Name)
+	if err != nil {
+		return fmt.Errorf("trading - checkRiskLimits - GetRiskLimits: %w", err)
+	}
+	if len(limits) == 0 {
+		return nil
+	}
+	positions, err := t.positionsRepository.GetUserPositions(ctx, position.User)
+	if err != nil {
+		return fmt.Errorf("trading - checkRiskLimits - GetUserPositions: %w", err)
+	}
+	for _, l := range limits {
+		err = l.Check(positions, position, notional, opening)
+		if err != nil {
+			return fmt.Errorf("trading - checkRiskLimits: %w", err)
+		}
+	}
+	return nil
+}
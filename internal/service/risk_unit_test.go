@@ -0,0 +1,93 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/OVantsevich/Trading-Service/internal/model"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTrading_CreatePosition_RiskLimits(t *testing.T) {
+	user := uuid.NewString()
+	positions := []*model.Position{
+		{User: user, Name: "risk", Amount: 5, PurchasePrice: 100, Status: model.PositionOpen},
+		{User: user, Name: "other", Amount: 2, PurchasePrice: 100, ShortPosition: true, Status: model.PositionPending},
+		{User: user, Name: "risk", Amount: 50, PurchasePrice: 100, Status: model.PositionClosed},
+	}
+	tests := []struct {
+		name   string
+		limits *model.RiskLimits
+		short  bool
+		err    string
+	}{
+		{name: "open positions", limits: &model.RiskLimits{MaxOpenPositions: 2}, err: "3 open positions, max 2"},
+		{name: "open positions of instrument", limits: &model.RiskLimits{Name: "risk", MaxOpenPositions: 2}},
+		{name: "notional", limits: &model.RiskLimits{Name: "risk", MaxNotional: 900}, err: "notional 1000 of risk, max 900"},
+		{name: "exposure", limits: &model.RiskLimits{MaxExposure: 1100}, err: "exposure 1200, max 1100"},
+		{name: "short exposure", limits: &model.RiskLimits{MaxShortExposure: 600}, short: true, err: "short exposure 700, max 600"},
+		{name: "short exposure of long", limits: &model.RiskLimits{MaxShortExposure: 600}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			trading, m := newMockedTrading(t)
+			position := &model.Position{User: user, Name: "risk", Amount: 5, ShortPosition: tt.short}
+
+			m.prices.On("GetCurrentPrices", mock.Anything, []string{position.Name}).Return(
+				map[string]*model.Price{position.Name: {Name: position.Name, PurchasePrice: 100, SellingPrice: 100}}, nil)
+			m.risk.ExpectedCalls = nil
+			m.risk.On("GetRiskLimits", mock.Anything, user, position.Name).Return([]*model.RiskLimits{tt.limits}, nil)
+			m.positions.On("GetUserPositions", mock.Anything, user).Return(positions, nil)
+			if tt.err == "" {
+				m.commissions.On("GetCommissionSchedule", mock.Anything, user, position.Name).Return(nil, nil)
+				m.positions.On("CreatePosition", mock.Anything, mock.Anything).Return(position, nil)
+				m.outbox.On("CreatePaymentCommand", mock.Anything, mock.Anything).Return(nil)
+			}
+
+			_, err := trading.CreatePosition(context.Background(), position, nil)
+			if tt.err == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, model.ErrRiskLimit)
+			require.ErrorContains(t, err, tt.err)
+		})
+	}
+}
+
+func TestTrading_IncreasePosition_RiskLimits(t *testing.T) {
+	trading, m := newMockedTrading(t)
+	position := &model.Position{ID: uuid.NewString(), User: uuid.NewString(), Name: "risk", Amount: 5, PurchasePrice: 100,
+		Leverage: 1, Status: model.PositionOpen}
+
+	m.positions.On("GetPositionByID", mock.Anything, position.ID).Return(position, nil)
+	m.prices.On("GetCurrentPrices", mock.Anything, []string{position.Name}).Return(
+		map[string]*model.Price{position.Name: {Name: position.Name, PurchasePrice: 100, SellingPrice: 100}}, nil)
+	m.risk.ExpectedCalls = nil
+	m.risk.On("GetRiskLimits", mock.Anything, position.User, position.Name).Return(
+		[]*model.RiskLimits{{MaxOpenPositions: 1, MaxNotional: 800}}, nil)
+	m.positions.On("GetUserPositions", mock.Anything, position.User).Return([]*model.Position{position}, nil)
+
+	_, err := trading.IncreasePosition(context.Background(), position.ID, 5, time.Now())
+	require.ErrorIs(t, err, model.ErrRiskLimit)
+	require.ErrorContains(t, err, "notional 1000 of risk, max 800")
+}
+
+func TestTrading_SetRiskLimits(t *testing.T) {
+	trading, m := newMockedTrading(t)
+
+	err := trading.SetRiskLimits(context.Background(), &model.RiskLimits{MaxExposure: 1})
+	require.ErrorIs(t, err, model.ErrInvalidRiskLimits)
+	err = trading.SetRiskLimits(context.Background(), &model.RiskLimits{User: "user", MaxNotional: -1})
+	require.ErrorIs(t, err, model.ErrInvalidRiskLimits)
+
+	m.risk.On("SetRiskLimits", mock.Anything, mock.MatchedBy(func(limits *model.RiskLimits) bool {
+		return limits.User == "user" && limits.MaxOpenPositions == 3 && !limits.Updated.IsZero()
+	})).Return(nil)
+	err = trading.SetRiskLimits(context.Background(), &model.RiskLimits{User: "user", MaxOpenPositions: 3})
+	require.NoError(t, err)
+}
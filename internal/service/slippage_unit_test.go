@@ -0,0 +1,56 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/OVantsevich/Trading-Service/internal/model"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTrading_CreatePosition_Slippage(t *testing.T) {
+	trading, m := newMockedTrading(t)
+	long := &model.Position{User: uuid.NewString(), Name: "slippage", Amount: 1}
+	short := &model.Position{User: uuid.NewString(), Name: "slippage", Amount: 1, ShortPosition: true}
+
+	m.prices.On("GetCurrentPrices", mock.Anything, []string{"slippage"}).Return(
+		map[string]*model.Price{"slippage": {Name: "slippage", PurchasePrice: 101.5, SellingPrice: 101.5}}, nil)
+
+	_, err := trading.CreatePosition(context.Background(), long, &model.Slippage{ExpectedPrice: 100, MaxSlippage: 1})
+	require.ErrorIs(t, err, model.ErrSlippageExceeded)
+
+	m.commissions.On("GetCommissionSchedule", mock.Anything, mock.Anything, "slippage").Return(nil, nil)
+	m.positions.On("CreatePosition", mock.Anything, mock.Anything).Return(func(_ context.Context, pos *model.Position) *model.Position { return pos }, nil)
+	m.outbox.On("CreatePaymentCommand", mock.Anything, mock.Anything).Return(nil)
+
+	pos, err := trading.CreatePosition(context.Background(), long, &model.Slippage{ExpectedPrice: 100, MaxSlippage: 200, BPS: true})
+	require.NoError(t, err)
+	require.Equal(t, 101.5, pos.PurchasePrice)
+	_, err = trading.CreatePosition(context.Background(), short, &model.Slippage{ExpectedPrice: 105, MaxSlippage: 1})
+	require.ErrorIs(t, err, model.ErrSlippageExceeded)
+	_, err = trading.CreatePosition(context.Background(), short, &model.Slippage{ExpectedPrice: 100, MaxSlippage: 0})
+	require.NoError(t, err)
+	m.positions.AssertNumberOfCalls(t, "CreatePosition", 2)
+}
+
+func TestTrading_ClosePosition_Slippage(t *testing.T) {
+	trading, m := newMockedTrading(t)
+	position := &model.Position{ID: uuid.NewString(), User: uuid.NewString(), Name: "slippage", Amount: 1, PurchasePrice: 100,
+		Status: model.PositionOpen}
+
+	m.positions.On("SetStatus", mock.Anything, position.ID, model.PositionClosing, mock.Anything).Return(model.PositionOpen, nil).Once()
+	m.positions.On("GetPositionByID", mock.Anything, position.ID).Return(position, nil)
+	m.prices.On("GetCurrentPrices", mock.Anything, []string{position.Name}).Return(
+		map[string]*model.Price{position.Name: {Name: position.Name, SellingPrice: 95}}, nil)
+	m.positions.On("RestoreStatus", mock.Anything, position.ID, mock.Anything).Return(nil).Once()
+
+	err := trading.ClosePosition(context.Background(), position.ID, &model.Slippage{ExpectedPrice: 100, MaxSlippage: 2}, time.Now().Unix(), time.Now())
+	require.ErrorIs(t, err, model.ErrSlippageExceeded)
+
+	err = trading.PartialClosePosition(context.Background(), position.ID, 0.5, &model.Slippage{ExpectedPrice: 100, MaxSlippage: 400, BPS: true}, time.Now())
+	require.ErrorIs(t, err, model.ErrSlippageExceeded)
+}
@@ -3,9 +3,12 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sort"
 	"time"
 
+	"github.com/OVantsevich/Trading-Service/internal/config"
 	"github.com/OVantsevich/Trading-Service/internal/model"
 	"github.com/OVantsevich/Trading-Service/internal/repository"
 
@@ -19,12 +22,24 @@ const stopLoss = "stop_loss"
 // takeProfit take profit
 const takeProfit = "take_profit"
 
+// trailingStop trailing stop
+const trailingStop = "trailing_stop"
+
 // closed
 const closed = "closed"
 
 // created
 const created = "created"
 
+// amountChanged
+const amountChanged = "amount_changed"
+
+// defaultPageSize positions page size if it isn't requested
+const defaultPageSize = 50
+
+// maxPageSize max positions page size
+const maxPageSize = 500
+
 // PositionsRepository positions repository
 //
 //go:generate mockery --name=PositionsRepository --case=underscore --output=./mocks
@@ -32,12 +47,24 @@ type PositionsRepository interface {
 	CreatePosition(ctx context.Context, position *model.Position) (*model.Position, error)
 	GetPositionByID(ctx context.Context, positionID string) (*model.Position, error)
 	GetUserPositions(ctx context.Context, userID string) ([]*model.Position, error)
+	GetOpenPositions(ctx context.Context) ([]*model.Position, error)
+	ListPositions(ctx context.Context, filter *model.PositionFilter) ([]*model.Position, string, error)
 	UpdatePosition(ctx context.Context, position *model.Position) error
 	SetStopLoss(ctx context.Context, positionID string, stopLoss float64, updated time.Time) error
 	SetTakeProfit(ctx context.Context, positionID string, takeProfit float64, updated time.Time) error
-	ClosePosition(ctx context.Context, id string, closed int64, sellingPrice float64, updated time.Time) (*model.Position, error)
+	SetTrailingStop(ctx context.Context, positionID string, trailingStop float64, percentage bool, updated time.Time) error
+	SetTrailingLevel(ctx context.Context, positionID string, level float64, updated time.Time) error
+	SetLiquidationReason(ctx context.Context, positionID, reason string, updated time.Time) error
+	SetStatus(ctx context.Context, positionID, status string, updated time.Time) (string, error)
+	ConfirmPosition(ctx context.Context, positionID string, updated time.Time) error
+	RestoreStatus(ctx context.Context, positionID string, updated time.Time) error
+	RestoreClosing(ctx context.Context, updated time.Time) error
+	ClosePosition(ctx context.Context, position *model.Position) (*model.Position, error)
+	CreateFill(ctx context.Context, fill *model.Fill) error
+	AddFinancing(ctx context.Context, positionID string, amount float64, updated time.Time) error
 
 	GetNotification(ctx context.Context) (*model.Notification, error)
+	AckNotification(notify *model.Notification)
 }
 
 // PriceService grpc price service
@@ -48,6 +75,7 @@ type PriceService interface {
 	UpdateSubscription(names []string) error
 
 	GetCurrentPrices(ctx context.Context, names []string) (map[string]*model.Price, error)
+	Stale() bool
 }
 
 // PaymentService grpc payment service
@@ -55,8 +83,9 @@ type PriceService interface {
 //go:generate mockery --name=PaymentService --case=underscore --output=./mocks
 type PaymentService interface {
 	GetAccountID(ctx context.Context, userID string) (string, error)
-	IncreaseAmount(ctx context.Context, accountID string, amount float64) error
-	DecreaseAmount(ctx context.Context, accountID string, amount float64) error
+	GetBalance(ctx context.Context, userID string) (float64, error)
+	IncreaseAmount(ctx context.Context, accountID string, amount float64, idempotencyKey string) error
+	DecreaseAmount(ctx context.Context, accountID string, amount float64, idempotencyKey string) error
 }
 
 // ListenersRepository pool of channels for TP and SL goroutines
@@ -67,21 +96,36 @@ type ListenersRepository interface {
 	CreateListenerSL(ctx context.Context, position *model.Position) error
 	RemoveListenerTP(position *model.Position) error
 	RemoveListenerSL(position *model.Position) error
+	CreateListenerTS(ctx context.Context, position *model.Position) error
+	RemoveListenerTS(position *model.Position) error
 
 	SendPrices(prices []*model.Price)
 	ClosePosition(ctx context.Context) (*model.Position, error)
+	RearmListeners(position *model.Position)
+	TrailingLevel(ctx context.Context) (*model.Position, error)
 }
 
 // ListenerPNL pnl listener
 //
-//go:generate mockery --name=ListenersRepository --case=underscore --output=./mocks
+//go:generate mockery --name=ListenerPNL --case=underscore --output=./mocks
 type ListenerPNL interface {
 	AddPositions(ctx context.Context, positions []*model.Position, prices map[string]*model.Price) error
 	RemovePosition(position *model.Position) error
+	UpdatePosition(position *model.Position) error
+	UpdateBalance(userID string, balance float64) error
+	SubscribePortfolio(userID string) (<-chan *model.Portfolio, func())
 	SendPricesPNL(prices []*model.Price)
 	ClosePosition(ctx context.Context) (*model.Position, error)
 }
 
+// EventsRepository subscribers of position and order events of users
+//
+//go:generate mockery --name=EventsRepository --case=underscore --output=./mocks
+type EventsRepository interface {
+	Subscribe(userID string) (<-chan *model.Event, func())
+	Publish(event *model.Event)
+}
+
 // Trading trading service
 type Trading struct {
 	positionsRepository PositionsRepository
@@ -89,54 +133,317 @@ type Trading struct {
 	paymentService      PaymentService
 	listenersRepository ListenersRepository
 	listenerPNL         ListenerPNL
+	ordersRepository    OrdersRepository
+	ordersListeners     OrdersListenersRepository
+
+	idempotencyRepository IdempotencyRepository
+	paymentOutbox         PaymentOutboxRepository
+	events                EventsRepository
+	commissions           CommissionRepository
+	financing             FinancingRepository
+	quotes                QuotesRepository
+	risk                  RiskRepository
+
+	maxLeverage           float64
+	maintenanceMarginRate float64
+
+	paymentPollInterval      time.Duration
+	paymentMaxAttempts       int
+	paymentBackoffMax        time.Duration
+	paymentReconcileInterval time.Duration
+
+	financingInterval time.Duration
+	financingRollover time.Duration
+
+	quoteTTL time.Duration
+
+	idempotencyTimeout time.Duration
 
 	transactor repository.PgxTransactor
 }
 
 // NewTrading constructor
-func NewTrading(ctx context.Context, lr ListenersRepository, pnllr ListenerPNL, pr PositionsRepository, pp PriceService, ps PaymentService, trx repository.PgxTransactor) *Trading {
-	prc := &Trading{positionsRepository: pr, priceService: pp, paymentService: ps, listenersRepository: lr, listenerPNL: pnllr, transactor: trx}
-	prc.startListener(ctx, getPricesListener).startListener(ctx, getNotificationListener).startListener(ctx, closePositionListener).startListener(ctx, closePositionListenerPNL)
+func NewTrading(ctx context.Context, cfg *config.MainConfig, lr ListenersRepository, pnllr ListenerPNL, olr OrdersListenersRepository, pr PositionsRepository, or OrdersRepository,
+	ir IdempotencyRepository, po PaymentOutboxRepository, er EventsRepository, cr CommissionRepository, fr FinancingRepository, qr QuotesRepository, rr RiskRepository, pp PriceService, ps PaymentService, trx repository.PgxTransactor) *Trading {
+	prc := &Trading{positionsRepository: pr, priceService: pp, paymentService: ps, listenersRepository: lr, listenerPNL: pnllr,
+		ordersRepository: or, ordersListeners: olr, idempotencyRepository: ir, paymentOutbox: po, events: er, commissions: cr, financing: fr, quotes: qr, risk: rr, maxLeverage: cfg.MaxLeverage, maintenanceMarginRate: cfg.MaintenanceMarginRate,
+		paymentPollInterval: cfg.PaymentPollInterval, paymentMaxAttempts: cfg.PaymentMaxAttempts, paymentBackoffMax: cfg.PaymentBackoffMax, paymentReconcileInterval: cfg.PaymentReconcileInterval,
+		financingInterval: cfg.FinancingInterval, financingRollover: cfg.FinancingRollover, quoteTTL: cfg.QuoteTTL, idempotencyTimeout: cfg.IdempotencyTimeout, transactor: trx}
+	prc.startListener(ctx, getPricesListener).startListener(ctx, getNotificationListener).startListener(ctx, closePositionListener).startListener(ctx, closePositionListenerPNL).
+		startListener(ctx, fillOrderListener).startListener(ctx, trailingLevelListener).startListener(ctx, paymentOutboxListener).
+		startListener(ctx, financingListener)
 	return prc
 }
 
-// CreatePosition open new position
-func (t *Trading) CreatePosition(ctx context.Context, position *model.Position) (*model.Position, error) {
+// RestoreListeners recreate thresholds, pnl and limit orders listeners of open positions and pending orders
+// and resubscribe for their prices, must be called before accepting requests;
+// closes interrupted by restart weren't committed, so their positions get back the status they had before closing
+func (t *Trading) RestoreListeners(ctx context.Context) error {
+	err := t.positionsRepository.RestoreClosing(ctx, time.Now())
+	if err != nil {
+		return fmt.Errorf("trading - RestoreListeners - RestoreClosing: %w", err)
+	}
+	positions, err := t.positionsRepository.GetOpenPositions(ctx)
+	if err != nil {
+		return fmt.Errorf("trading - RestoreListeners - GetOpenPositions: %w", err)
+	}
+	orders, err := t.ordersRepository.GetPendingOrders(ctx)
+	if err != nil {
+		return fmt.Errorf("trading - RestoreListeners - GetPendingOrders: %w", err)
+	}
+
+	names := make([]string, 0)
+	subscribed := make(map[string]bool)
+	userPositions := make(map[string][]*model.Position)
+	for _, p := range positions {
+		if !subscribed[p.Name] {
+			subscribed[p.Name] = true
+			names = append(names, p.Name)
+		}
+		userPositions[p.User] = append(userPositions[p.User], p)
+	}
+	for _, o := range orders {
+		if !subscribed[o.Name] {
+			subscribed[o.Name] = true
+			names = append(names, o.Name)
+		}
+	}
+	if len(names) == 0 {
+		return nil
+	}
+
+	prices, err := t.priceService.GetCurrentPrices(ctx, names)
+	if err != nil {
+		return fmt.Errorf("trading - RestoreListeners - GetCurrentPrices: %w", err)
+	}
+	for _, p := range positions {
+		err = t.restoreThresholds(ctx, p)
+		if err != nil {
+			return fmt.Errorf("trading - RestoreListeners - restoreThresholds: %w", err)
+		}
+	}
+	for user, up := range userPositions {
+		up = pricedPositions(up, prices)
+		if len(up) == 0 {
+			continue
+		}
+		err = t.refreshBalance(ctx, user)
+		if err != nil {
+			return fmt.Errorf("trading - RestoreListeners - refreshBalance: %w", err)
+		}
+		err = t.listenerPNL.AddPositions(ctx, up, prices)
+		if err != nil {
+			return fmt.Errorf("trading - RestoreListeners - AddPositions: %w", err)
+		}
+	}
+	for _, o := range orders {
+		err = t.ordersListeners.CreateListener(ctx, o)
+		if err != nil {
+			return fmt.Errorf("trading - RestoreListeners - CreateListener: %w", err)
+		}
+	}
+
+	err = t.priceService.UpdateSubscription(names)
+	if err != nil {
+		return fmt.Errorf("trading - RestoreListeners - UpdateSubscription: %w", err)
+	}
+	return nil
+}
+
+// restoreThresholds create listeners for stop loss, take profit and trailing stop of position
+func (t *Trading) restoreThresholds(ctx context.Context, position *model.Position) error {
+	if position.TakeProfit > 0 {
+		err := t.listenersRepository.CreateListenerTP(ctx, position)
+		if err != nil {
+			return fmt.Errorf("trading - restoreThresholds - CreateListenerTP: %w", err)
+		}
+	}
+	if position.StopLoss > 0 {
+		err := t.listenersRepository.CreateListenerSL(ctx, position)
+		if err != nil {
+			return fmt.Errorf("trading - restoreThresholds - CreateListenerSL: %w", err)
+		}
+	}
+	if position.TrailingStop > 0 {
+		err := t.listenersRepository.CreateListenerTS(ctx, position)
+		if err != nil {
+			return fmt.Errorf("trading - restoreThresholds - CreateListenerTS: %w", err)
+		}
+	}
+	return nil
+}
+
+// CreatePosition open new position at current price, position isn't opened if purchase price is worse than slippage allows
+func (t *Trading) CreatePosition(ctx context.Context, position *model.Position, slippage *model.Slippage) (*model.Position, error) {
+	if t.priceService.Stale() {
+		return nil, fmt.Errorf("trading - CreatePosition: %w", model.ErrPricesStale)
+	}
+	var pos *model.Position
+	err := t.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		response, trxErr := t.priceService.GetCurrentPrices(ctx, []string{position.Name})
+		if trxErr != nil {
+			return fmt.Errorf("trading - CreatePosition - GetCurrentPrices: %w", trxErr)
+		}
+		price, ok := response[position.Name]
+		if !ok {
+			return fmt.Errorf("trading - CreatePosition: no price of %s", position.Name)
+		}
+		if slippage.Exceeded(price.PurchasePrice, !position.ShortPosition) {
+			return fmt.Errorf("trading - CreatePosition: %w, purchase price %v, expected %v", model.ErrSlippageExceeded,
+				price.PurchasePrice, slippage.ExpectedPrice)
+		}
+
+		pos, trxErr = t.openPosition(ctx, position, price)
+		if trxErr != nil {
+			return fmt.Errorf("trading - CreatePosition - openPosition: %w", trxErr)
+		}
+		return nil
+	})
+
+	return pos, err
+}
+
+// openPosition open position at given price within transaction of caller,
+// only initial margin of leveraged position and opening fee are debited by payment outbox
+func (t *Trading) openPosition(ctx context.Context, position *model.Position, price *model.Price) (*model.Position, error) {
+	if position.Leverage == 0 {
+		position.Leverage = 1
+	}
+	if position.Leverage < 1 || position.Leverage > t.maxLeverage {
+		return nil, fmt.Errorf("trading - openPosition: leverage must be between 1 and %v", t.maxLeverage)
+	}
+
+	position.PurchasePrice = price.PurchasePrice
+	err := t.checkRiskLimits(ctx, position, position.Amount*position.PurchasePrice, true)
+	if err != nil {
+		return nil, fmt.Errorf("trading - openPosition - checkRiskLimits: %w", err)
+	}
+
+	position.ID = uuid.New().String()
+	position.Status = model.PositionPending
+	t.setMargin(position)
+	fee, err := t.fee(ctx, position.User, position.Name, position.Amount*position.PurchasePrice)
+	if err != nil {
+		return nil, fmt.Errorf("trading - openPosition - fee: %w", err)
+	}
+	position.Fees = fee
+	position.RealizedPNL = -fee
+	pos, err := t.positionsRepository.CreatePosition(ctx, position)
+	if err != nil {
+		return nil, fmt.Errorf("trading - openPosition - CreatePosition: %w", err)
+	}
+
+	err = t.setBracket(ctx, position, price)
+	if err != nil {
+		return nil, fmt.Errorf("trading - openPosition - setBracket: %w", err)
+	}
+
+	err = t.enqueuePayment(ctx, position, -position.Margin-position.Fees, model.PaymentOpen, nil)
+	if err != nil {
+		return nil, fmt.Errorf("trading - openPosition - enqueuePayment: %w", err)
+	}
+	return pos, nil
+}
+
+// setBracket set stop loss and take profit of new position within its opening transaction
+func (t *Trading) setBracket(ctx context.Context, position *model.Position, price *model.Price) error {
+	if position.StopLoss > 0 {
+		if position.StopLoss >= price.SellingPrice != position.ShortPosition {
+			return fmt.Errorf("trading - setBracket: stop loss %v would be triggered at current price %v", position.StopLoss, price.SellingPrice)
+		}
+		err := t.positionsRepository.SetStopLoss(ctx, position.ID, position.StopLoss, position.Updated)
+		if err != nil {
+			return fmt.Errorf("trading - setBracket - SetStopLoss: %w", err)
+		}
+	}
+	if position.TakeProfit > 0 {
+		if position.TakeProfit <= price.SellingPrice != position.ShortPosition {
+			return fmt.Errorf("trading - setBracket: take profit %v would be triggered at current price %v", position.TakeProfit, price.SellingPrice)
+		}
+		err := t.positionsRepository.SetTakeProfit(ctx, position.ID, position.TakeProfit, position.Updated)
+		if err != nil {
+			return fmt.Errorf("trading - setBracket - SetTakeProfit: %w", err)
+		}
+	}
+	return nil
+}
+
+// setMargin recalculate initial and maintenance margin of position from its notional
+func (t *Trading) setMargin(position *model.Position) {
+	notional := position.Amount * position.PurchasePrice
+	position.Margin = notional / position.Leverage
+	position.MaintenanceMargin = notional * t.maintenanceMarginRate
+}
+
+// IncreasePosition buy more of open position, purchase price becomes volume-weighted average, bought amount is charged with fee
+func (t *Trading) IncreasePosition(ctx context.Context, positionID string, amount float64, updated time.Time) (*model.Position, error) {
+	if amount <= 0 {
+		return nil, fmt.Errorf("trading - IncreasePosition: amount must be positive")
+	}
+	if t.priceService.Stale() {
+		return nil, fmt.Errorf("trading - IncreasePosition: %w", model.ErrPricesStale)
+	}
 	var pos *model.Position
 	err := t.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
 		var trxErr error
+		pos, trxErr = t.positionsRepository.GetPositionByID(ctx, positionID)
+		if trxErr != nil {
+			return fmt.Errorf("trading - IncreasePosition - GetPositionByID: %w", trxErr)
+		}
+		trxErr = checkActive(pos)
+		if trxErr != nil {
+			return fmt.Errorf("trading - IncreasePosition: %w", trxErr)
+		}
 
 		var response map[string]*model.Price
-		response, trxErr = t.priceService.GetCurrentPrices(ctx, []string{position.Name})
+		response, trxErr = t.priceService.GetCurrentPrices(ctx, []string{pos.Name})
 		if trxErr != nil {
-			return fmt.Errorf("trading - CreatePosition - GetCurrentPrices: %w", trxErr)
+			return fmt.Errorf("trading - IncreasePosition - GetCurrentPrices: %w", trxErr)
+		}
+		price, ok := response[pos.Name]
+		if !ok {
+			return fmt.Errorf("trading - IncreasePosition: no price of %s", pos.Name)
 		}
-		price := response[position.Name]
 
-		position.PurchasePrice = price.PurchasePrice
-		position.ID = uuid.New().String()
-		pos, trxErr = t.positionsRepository.CreatePosition(ctx, position)
+		trxErr = t.checkRiskLimits(ctx, pos, amount*price.PurchasePrice, false)
 		if trxErr != nil {
-			return fmt.Errorf("trading - CreatePosition - CreatePosition: %w", trxErr)
+			return fmt.Errorf("trading - IncreasePosition - checkRiskLimits: %w", trxErr)
 		}
 
-		var accountID string
-		accountID, trxErr = t.paymentService.GetAccountID(ctx, position.User)
+		var fee float64
+		fee, trxErr = t.fee(ctx, pos.User, pos.Name, amount*price.PurchasePrice)
 		if trxErr != nil {
-			trxErr = fmt.Errorf("trading - CreatePosition - GetAccount: %w", trxErr)
-			return trxErr
+			return fmt.Errorf("trading - IncreasePosition - fee: %w", trxErr)
 		}
 
-		sum := position.Amount * price.PurchasePrice
-		trxErr = t.paymentService.DecreaseAmount(ctx, accountID, sum)
+		// unpaid increase is reverted to snapshot only if position isn't updated after the increase
+		snapshot := *pos
+		snapshot.Updated = updated
+		total := pos.Amount + amount
+		pos.PurchasePrice = (pos.Amount*pos.PurchasePrice + amount*price.PurchasePrice) / total
+		pos.Amount = total
+		pos.Fees += fee
+		pos.RealizedPNL -= fee
+		pos.Updated = updated
+		if pos.Leverage < 1 {
+			pos.Leverage = 1
+		}
+		t.setMargin(pos)
+		trxErr = t.positionsRepository.UpdatePosition(ctx, pos)
 		if trxErr != nil {
-			trxErr = fmt.Errorf("trading - CreatePosition - DecreaseAmount: %w", trxErr)
-			return trxErr
+			return fmt.Errorf("trading - IncreasePosition - UpdatePosition: %w", trxErr)
+		}
+
+		trxErr = t.enqueuePayment(ctx, pos, -amount*price.PurchasePrice/pos.Leverage-fee, model.PaymentScale, &snapshot)
+		if trxErr != nil {
+			return fmt.Errorf("trading - IncreasePosition - enqueuePayment: %w", trxErr)
 		}
 		return nil
 	})
-
-	return pos, err
+	if err != nil {
+		return nil, err
+	}
+	return pos, nil
 }
 
 // GetPositionByID get position by id
@@ -157,6 +464,138 @@ func (t *Trading) GetUserPositions(ctx context.Context, userID string) ([]*model
 	return pos, nil
 }
 
+// GetPortfolioSummary aggregate realized pnl of all user positions and unrealized pnl and exposure of open ones at current prices
+func (t *Trading) GetPortfolioSummary(ctx context.Context, userID string) (*model.PortfolioSummary, error) {
+	positions, err := t.positionsRepository.GetUserPositions(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("trading - GetPortfolioSummary - GetUserPositions: %w", err)
+	}
+
+	summary := &model.PortfolioSummary{User: userID, Instruments: make([]*model.InstrumentSummary, 0)}
+	instruments := make(map[string]*model.InstrumentSummary)
+	names := make([]string, 0)
+	for _, p := range positions {
+		instrument, ok := instruments[p.Name]
+		if !ok {
+			instrument = &model.InstrumentSummary{Name: p.Name}
+			instruments[p.Name] = instrument
+			summary.Instruments = append(summary.Instruments, instrument)
+		}
+		instrument.RealizedPNL += p.RealizedPNL
+		if p.Closed == 0 {
+			if instrument.OpenPositions == 0 {
+				names = append(names, p.Name)
+			}
+			instrument.OpenPositions++
+		}
+	}
+	if len(names) > 0 {
+		prices, err := t.priceService.GetCurrentPrices(ctx, names)
+		if err != nil {
+			return nil, fmt.Errorf("trading - GetPortfolioSummary - GetCurrentPrices: %w", err)
+		}
+		for _, p := range positions {
+			if p.Closed != 0 {
+				continue
+			}
+			price, ok := prices[p.Name]
+			if !ok {
+				return nil, fmt.Errorf("trading - GetPortfolioSummary: no price of %s", p.Name)
+			}
+			instrument := instruments[p.Name]
+			notional := p.Amount * price.SellingPrice
+			instrument.UnrealizedPNL += realizedPNL(p, p.Amount, price.SellingPrice)
+			instrument.GrossExposure += notional
+			if p.ShortPosition {
+				notional = -notional
+			}
+			instrument.NetExposure += notional
+		}
+	}
+
+	sort.Slice(summary.Instruments, func(i, j int) bool { return summary.Instruments[i].Name < summary.Instruments[j].Name })
+	for _, instrument := range summary.Instruments {
+		summary.OpenPositions += instrument.OpenPositions
+		summary.RealizedPNL += instrument.RealizedPNL
+		summary.UnrealizedPNL += instrument.UnrealizedPNL
+		summary.GrossExposure += instrument.GrossExposure
+		summary.NetExposure += instrument.NetExposure
+	}
+	return summary, nil
+}
+
+// ListPositions get filtered page of user positions and cursor of the next page
+func (t *Trading) ListPositions(ctx context.Context, filter *model.PositionFilter) ([]*model.Position, string, error) {
+	switch {
+	case filter.Limit <= 0:
+		filter.Limit = defaultPageSize
+	case filter.Limit > maxPageSize:
+		filter.Limit = maxPageSize
+	}
+	positions, next, err := t.positionsRepository.ListPositions(ctx, filter)
+	if err != nil {
+		return nil, "", fmt.Errorf("trading - ListPositions - ListPositions: %w", err)
+	}
+	return positions, next, nil
+}
+
+// StreamPortfolio send snapshots of user portfolio valued by pnl listener until context is done or send fails,
+// free balance is refreshed first, so user without positions gets it in the first snapshot
+func (t *Trading) StreamPortfolio(ctx context.Context, userID string, send func(portfolio *model.Portfolio) error) error {
+	err := t.refreshBalance(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("trading - StreamPortfolio - refreshBalance: %w", err)
+	}
+	portfolios, unsubscribe := t.listenerPNL.SubscribePortfolio(userID)
+	defer unsubscribe()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case portfolio := <-portfolios:
+			err := send(portfolio)
+			if err != nil {
+				return fmt.Errorf("trading - StreamPortfolio - send: %w", err)
+			}
+		}
+	}
+}
+
+// SubscribeEvents send position and order events of user until context is done or send fails,
+// events are delivered at least once: after lost listen connection they can be repeated
+func (t *Trading) SubscribeEvents(ctx context.Context, userID string, send func(event *model.Event) error) error {
+	events, unsubscribe := t.events.Subscribe(userID)
+	defer unsubscribe()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok := <-events:
+			if !ok {
+				return fmt.Errorf("trading - SubscribeEvents: %w", model.ErrEventsOverflow)
+			}
+			err := send(event)
+			if err != nil {
+				return fmt.Errorf("trading - SubscribeEvents - send: %w", err)
+			}
+		}
+	}
+}
+
+// publishEvent send notification to subscribers of its user
+func (t *Trading) publishEvent(notify *model.Notification) {
+	event := &model.Event{Type: notify.Type, Position: notify.Position, Order: notify.Order, Created: time.Now()}
+	switch {
+	case notify.Order != nil:
+		event.User = notify.Order.User
+	case notify.Position != nil:
+		event.User = notify.User
+	default:
+		return
+	}
+	t.events.Publish(event)
+}
+
 // SetStopLoss set stop loss
 func (t *Trading) SetStopLoss(ctx context.Context, positionID string, stopLoss float64, updated time.Time) error {
 	err := t.positionsRepository.SetStopLoss(ctx, positionID, stopLoss, updated)
@@ -175,84 +614,257 @@ func (t *Trading) SetTakeProfit(ctx context.Context, positionID string, takeProf
 	return nil
 }
 
-// ClosePosition close position
-func (t *Trading) ClosePosition(ctx context.Context, positionID string, closed int64, updated time.Time) error {
+// SetTrailingStop set trailing stop with fixed distance or percentage
+func (t *Trading) SetTrailingStop(ctx context.Context, positionID string, trailingStop float64, percentage bool, updated time.Time) error {
+	err := t.positionsRepository.SetTrailingStop(ctx, positionID, trailingStop, percentage, updated)
+	if err != nil {
+		return fmt.Errorf("trading - SetTrailingStop - SetTrailingStop: %w", err)
+	}
+	return nil
+}
+
+// ClosePosition close position by user, concurrent close of the same position is rejected,
+// position isn't closed if selling price is worse than slippage allows
+func (t *Trading) ClosePosition(ctx context.Context, positionID string, slippage *model.Slippage, closed int64, updated time.Time) error {
+	return t.guardClose(ctx, positionID, updated, func(ctx context.Context) error {
+		return t.closePosition(ctx, positionID, model.CloseManual, 0, slippage, closed, updated)
+	})
+}
+
+// closeTriggered close position which stop loss, take profit or trailing stop was crossed
+func (t *Trading) closeTriggered(ctx context.Context, position *model.Position, closed int64, updated time.Time) error {
+	return t.guardClose(ctx, position.ID, updated, func(ctx context.Context) error {
+		return t.closePosition(ctx, position.ID, position.CloseReason, position.TriggerPrice, nil, closed, updated)
+	})
+}
+
+// liquidatePosition force close position recording the liquidation reason
+func (t *Trading) liquidatePosition(ctx context.Context, position *model.Position, closed int64, updated time.Time) error {
+	return t.guardClose(ctx, position.ID, updated, func(ctx context.Context) error {
+		err := t.positionsRepository.SetLiquidationReason(ctx, position.ID, position.LiquidationReason, updated)
+		if err != nil {
+			return fmt.Errorf("trading - liquidatePosition - SetLiquidationReason: %w", err)
+		}
+		return t.closePosition(ctx, position.ID, model.CloseLiquidation, position.TriggerPrice, nil, closed, updated)
+	})
+}
+
+// pricedPositions positions which instrument is quoted by price service, the rest aren't tracked by pnl listener
+func pricedPositions(positions []*model.Position, prices map[string]*model.Price) []*model.Position {
+	priced := make([]*model.Position, 0, len(positions))
+	for _, p := range positions {
+		if _, ok := prices[p.Name]; !ok {
+			logrus.Warnf("trading - pricedPositions: no price of %s, pnl of position %s isn't tracked", p.Name, p.ID)
+			continue
+		}
+		priced = append(priced, p)
+	}
+	return priced
+}
+
+// guardClose mark position as closing before close transaction, so other closes are rejected while it is in flight,
+// previous status is restored if close transaction fails
+func (t *Trading) guardClose(ctx context.Context, positionID string, updated time.Time, closeFn func(ctx context.Context) error) error {
+	_, err := t.positionsRepository.SetStatus(ctx, positionID, model.PositionClosing, updated)
+	if err != nil {
+		return fmt.Errorf("trading - guardClose - SetStatus: %w", err)
+	}
+
+	err = t.transactor.WithinTransaction(ctx, closeFn)
+	if err != nil {
+		restoreErr := t.positionsRepository.RestoreStatus(ctx, positionID, time.Now())
+		if restoreErr != nil {
+			logrus.Errorf("trading - guardClose - RestoreStatus: %v", restoreErr)
+		}
+		return err
+	}
+	return nil
+}
+
+// closeRejected position was closed or is being closed by someone else
+func closeRejected(err error) bool {
+	return errors.Is(err, model.ErrPositionClosing) || errors.Is(err, model.ErrPositionClosed)
+}
+
+// checkActive return error if position is being closed or already closed
+func checkActive(pos *model.Position) error {
+	switch pos.Status {
+	case model.PositionClosing:
+		return model.ErrPositionClosing
+	case model.PositionClosed, model.PositionFailed:
+		return model.ErrPositionClosed
+	}
+	if pos.Closed != 0 {
+		return model.ErrPositionClosed
+	}
+	return nil
+}
+
+// closePosition close position within transaction recording why and at which price it was triggered
+func (t *Trading) closePosition(ctx context.Context, positionID, reason string, triggerPrice float64, slippage *model.Slippage,
+	closed int64, updated time.Time) error {
+	pos, err := t.positionsRepository.GetPositionByID(ctx, positionID)
+	if err != nil {
+		return fmt.Errorf("trading - ClosePosition - GetPositionByID: %w", err)
+	}
+
+	response, err := t.priceService.GetCurrentPrices(ctx, []string{pos.Name})
+	if err != nil {
+		return fmt.Errorf("trading - ClosePosition - GetCurrentPrices: %w", err)
+	}
+	price, ok := response[pos.Name]
+	if !ok {
+		return fmt.Errorf("trading - ClosePosition: no price of %s", pos.Name)
+	}
+	if slippage.Exceeded(price.SellingPrice, pos.ShortPosition) {
+		return fmt.Errorf("trading - ClosePosition: %w, selling price %v, expected %v", model.ErrSlippageExceeded,
+			price.SellingPrice, slippage.ExpectedPrice)
+	}
+
+	fee, err := t.fee(ctx, pos.User, pos.Name, pos.Amount*price.SellingPrice)
+	if err != nil {
+		return fmt.Errorf("trading - ClosePosition - fee: %w", err)
+	}
+	pnl := realizedPNL(pos, pos.Amount, price.SellingPrice) - fee
+	pos, err = t.positionsRepository.ClosePosition(ctx, &model.Position{
+		ID:           positionID,
+		Status:       model.PositionClosed,
+		Closed:       closed,
+		SellingPrice: price.SellingPrice,
+		CloseReason:  reason,
+		TriggerPrice: triggerPrice,
+		RealizedPNL:  pnl,
+		Fees:         fee,
+		Updated:      updated,
+	})
+	if err != nil {
+		return fmt.Errorf("trading - ClosePosition - ClosePosition: %w", err)
+	}
+
+	err = t.positionsRepository.CreateFill(ctx, &model.Fill{
+		ID:         uuid.New().String(),
+		PositionID: positionID,
+		Amount:     pos.Amount,
+		Price:      price.SellingPrice,
+		Created:    updated,
+	})
+	if err != nil {
+		return fmt.Errorf("trading - ClosePosition - CreateFill: %w", err)
+	}
+
+	err = t.settle(ctx, pos, pos.InitialMargin(), pnl)
+	if err != nil {
+		return fmt.Errorf("trading - ClosePosition - settle: %w", err)
+	}
+	return nil
+}
+
+// PartialClosePosition close given amount of position, closes whole position if amount isn't less than position amount
+func (t *Trading) PartialClosePosition(ctx context.Context, positionID string, amount float64, slippage *model.Slippage, updated time.Time) error {
+	if amount <= 0 {
+		return fmt.Errorf("trading - PartialClosePosition: amount must be positive")
+	}
+	var full bool
 	err := t.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
 		pos, trxErr := t.positionsRepository.GetPositionByID(ctx, positionID)
 		if trxErr != nil {
-			return fmt.Errorf("trading - ClosePosition - GetPositionByID: %w", trxErr)
+			return fmt.Errorf("trading - PartialClosePosition - GetPositionByID: %w", trxErr)
+		}
+		trxErr = checkActive(pos)
+		if trxErr != nil {
+			return fmt.Errorf("trading - PartialClosePosition: %w", trxErr)
+		}
+		if amount >= pos.Amount {
+			full = true
+			return nil
 		}
 
 		response, trxErr := t.priceService.GetCurrentPrices(ctx, []string{pos.Name})
 		if trxErr != nil {
-			return fmt.Errorf("trading - ClosePosition - GetCurrentPrices: %w", trxErr)
+			return fmt.Errorf("trading - PartialClosePosition - GetCurrentPrices: %w", trxErr)
+		}
+		price, ok := response[pos.Name]
+		if !ok {
+			return fmt.Errorf("trading - PartialClosePosition: no price of %s", pos.Name)
+		}
+		if slippage.Exceeded(price.SellingPrice, pos.ShortPosition) {
+			return fmt.Errorf("trading - PartialClosePosition: %w, selling price %v, expected %v", model.ErrSlippageExceeded,
+				price.SellingPrice, slippage.ExpectedPrice)
 		}
-		price := response[pos.Name]
 
-		pos, trxErr = t.positionsRepository.ClosePosition(ctx, positionID, closed, price.SellingPrice, updated)
+		fee, trxErr := t.fee(ctx, pos.User, pos.Name, amount*price.SellingPrice)
 		if trxErr != nil {
-			return fmt.Errorf("trading - ClosePosition - ClosePosition: %w", trxErr)
+			return fmt.Errorf("trading - PartialClosePosition - fee: %w", trxErr)
 		}
 
-		var sum float64
-		if !pos.ShortPosition {
-			var accountID string
-			accountID, trxErr = t.paymentService.GetAccountID(ctx, pos.User)
-			if trxErr != nil {
-				trxErr = fmt.Errorf("trading - ClosePosition - GetAccountID: %w", trxErr)
-				return trxErr
-			}
-
-			sum = pos.Amount * price.SellingPrice
-			trxErr = t.paymentService.IncreaseAmount(ctx, accountID, sum)
-			if trxErr != nil {
-				trxErr = fmt.Errorf("trading - ClosePosition - IncreaseAmount: %w", trxErr)
-				return trxErr
-			}
-		} else {
-			if pos.PurchasePrice >= price.SellingPrice {
-				sum = pos.Amount * (pos.PurchasePrice + pos.PurchasePrice - price.SellingPrice)
-
-				var accountID string
-				accountID, trxErr = t.paymentService.GetAccountID(ctx, pos.User)
-				if trxErr != nil {
-					trxErr = fmt.Errorf("trading - ClosePosition - GetAccountID: %w", trxErr)
-					return trxErr
-				}
-
-				trxErr = t.paymentService.IncreaseAmount(ctx, accountID, sum)
-				if trxErr != nil {
-					trxErr = fmt.Errorf("trading - ClosePosition - IncreaseAmount: %w", trxErr)
-					return trxErr
-				}
-			} else {
-				sum = pos.Amount * pos.PurchasePrice
-
-				var accountID string
-				accountID, trxErr = t.paymentService.GetAccountID(ctx, pos.User)
-				if trxErr != nil {
-					trxErr = fmt.Errorf("trading - ClosePosition - GetAccountID: %w", trxErr)
-					return trxErr
-				}
-
-				trxErr = t.paymentService.IncreaseAmount(ctx, accountID, sum)
-				if trxErr != nil {
-					trxErr = fmt.Errorf("trading - ClosePosition - IncreaseAmount: %w", trxErr)
-					return trxErr
-				}
+		margin := pos.InitialMargin() * amount / pos.Amount
+		pnl := realizedPNL(pos, amount, price.SellingPrice) - fee
+		pos.Margin = pos.InitialMargin() - margin
+		pos.MaintenanceMargin -= pos.MaintenanceMargin * amount / pos.Amount
+		pos.Amount -= amount
+		pos.RealizedPNL += pnl
+		pos.Fees += fee
+		pos.Updated = updated
+		trxErr = t.positionsRepository.UpdatePosition(ctx, pos)
+		if trxErr != nil {
+			return fmt.Errorf("trading - PartialClosePosition - UpdatePosition: %w", trxErr)
+		}
 
-				sum = pos.Amount * (price.SellingPrice - pos.PurchasePrice)
+		trxErr = t.positionsRepository.CreateFill(ctx, &model.Fill{
+			ID:         uuid.New().String(),
+			PositionID: positionID,
+			Amount:     amount,
+			Price:      price.SellingPrice,
+			Created:    updated,
+		})
+		if trxErr != nil {
+			return fmt.Errorf("trading - PartialClosePosition - CreateFill: %w", trxErr)
+		}
 
-				trxErr = t.paymentService.DecreaseAmount(ctx, accountID, sum)
-				if trxErr != nil {
-					trxErr = fmt.Errorf("trading - ClosePosition - DecreaseAmount: %w", trxErr)
-					return trxErr
-				}
-			}
+		trxErr = t.settle(ctx, pos, margin, pnl)
+		if trxErr != nil {
+			return fmt.Errorf("trading - PartialClosePosition - settle: %w", trxErr)
 		}
 		return nil
 	})
-	return err
+	if err != nil {
+		return err
+	}
+	if full {
+		return t.ClosePosition(ctx, positionID, slippage, updated.Unix(), updated)
+	}
+	return nil
+}
+
+// realizedPNL pnl of closing given amount of position at selling price
+func realizedPNL(pos *model.Position, amount, sellingPrice float64) float64 {
+	pnl := amount * (sellingPrice - pos.PurchasePrice)
+	if pos.ShortPosition {
+		return -pnl
+	}
+	return pnl
+}
+
+// settle return to the user account margin of closed amount of position with realized pnl net of fee
+func (t *Trading) settle(ctx context.Context, pos *model.Position, margin, pnl float64) error {
+	err := t.enqueuePayment(ctx, pos, margin+pnl, model.PaymentSettle, nil)
+	if err != nil {
+		return fmt.Errorf("trading - settle - enqueuePayment: %w", err)
+	}
+	return nil
+}
+
+// refreshBalance update free balance of user which is taken into account by portfolio pnl listener
+func (t *Trading) refreshBalance(ctx context.Context, userID string) error {
+	balance, err := t.paymentService.GetBalance(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("trading - refreshBalance - GetBalance: %w", err)
+	}
+	err = t.listenerPNL.UpdateBalance(userID, balance)
+	if err != nil {
+		return fmt.Errorf("trading - refreshBalance - UpdateBalance: %w", err)
+	}
+	return nil
 }
 
 func (t *Trading) startListener(ctx context.Context, listener func(context.Context, *Trading, chan error)) *Trading {
@@ -282,6 +894,7 @@ func getPricesListener(ctx context.Context, t *Trading, errChan chan error) {
 			}
 			t.listenersRepository.SendPrices(prices)
 			t.listenerPNL.SendPricesPNL(prices)
+			t.ordersListeners.SendPrices(prices)
 		}
 	}
 }
@@ -296,6 +909,7 @@ func getNotificationListener(ctx context.Context, t *Trading, errChan chan error
 				errChan <- fmt.Errorf("trading - getNotificationListener - GetNotification: %w", err)
 				continue
 			}
+			t.publishEvent(notify)
 
 			switch notify.Type {
 			case takeProfit:
@@ -318,6 +932,24 @@ func getNotificationListener(ctx context.Context, t *Trading, errChan chan error
 				if err != nil {
 					errChan <- fmt.Errorf("trading - getNotificationListener - UpdateSubscription: %w", err)
 				}
+			case trailingStop:
+				if notify.TrailingStop <= 0.0 {
+					err = t.listenersRepository.RemoveListenerTS(notify.Position)
+					if err != nil {
+						errChan <- fmt.Errorf("trading - getNotificationListener - RemoveListenerTS: %w", err)
+						continue
+					}
+					break
+				}
+				err = t.listenersRepository.CreateListenerTS(ctx, notify.Position)
+				if err != nil {
+					errChan <- fmt.Errorf("trading - getNotificationListener - CreateListenerTS: %w", err)
+					continue
+				}
+				err = t.priceService.UpdateSubscription([]string{notify.Name})
+				if err != nil {
+					errChan <- fmt.Errorf("trading - getNotificationListener - UpdateSubscription: %w", err)
+				}
 			case closed:
 				if notify.TakeProfit > 0.0 {
 					err = t.listenersRepository.RemoveListenerTP(notify.Position)
@@ -333,12 +965,23 @@ func getNotificationListener(ctx context.Context, t *Trading, errChan chan error
 						continue
 					}
 				}
+				if notify.TrailingStop > 0.0 {
+					err = t.listenersRepository.RemoveListenerTS(notify.Position)
+					if err != nil {
+						errChan <- fmt.Errorf("trading - getNotificationListener - RemoveListenerTS: %w", err)
+						continue
+					}
+				}
 				err = t.listenerPNL.RemovePosition(notify.Position)
 				if err != nil {
 					errChan <- fmt.Errorf("trading - getNotificationListener - RemovePosition: %w", err)
 					continue
 				}
 			case created:
+				err = t.refreshBalance(ctx, notify.User)
+				if err != nil {
+					errChan <- fmt.Errorf("trading - getNotificationListener - refreshBalance: %w", err)
+				}
 				prices, err := t.priceService.GetCurrentPrices(ctx, []string{notify.Name})
 				if err != nil {
 					errChan <- fmt.Errorf("trading - getNotificationListener - GetCurrentPrices: %w", err)
@@ -349,7 +992,30 @@ func getNotificationListener(ctx context.Context, t *Trading, errChan chan error
 					errChan <- fmt.Errorf("trading - getNotificationListener - AddPositions: %w", err)
 					continue
 				}
+			case amountChanged:
+				err = t.listenerPNL.UpdatePosition(notify.Position)
+				if err != nil {
+					errChan <- fmt.Errorf("trading - getNotificationListener - UpdatePosition: %w", err)
+					continue
+				}
+			case orderPlaced:
+				err = t.ordersListeners.CreateListener(ctx, notify.Order)
+				if err != nil {
+					errChan <- fmt.Errorf("trading - getNotificationListener - CreateListener: %w", err)
+					continue
+				}
+				err = t.priceService.UpdateSubscription([]string{notify.Order.Name})
+				if err != nil {
+					errChan <- fmt.Errorf("trading - getNotificationListener - UpdateSubscription: %w", err)
+				}
+			case orderClosed:
+				err = t.ordersListeners.RemoveListener(notify.Order)
+				if err != nil {
+					errChan <- fmt.Errorf("trading - getNotificationListener - RemoveListener: %w", err)
+					continue
+				}
 			}
+			t.positionsRepository.AckNotification(notify)
 		}
 	}
 }
@@ -364,8 +1030,11 @@ func closePositionListener(ctx context.Context, t *Trading, errChan chan error)
 				errChan <- fmt.Errorf("trading - closePositionListener - ClosePosition: %w", err)
 				continue
 			}
-			err = t.ClosePosition(ctx, notify.ID, time.Now().Unix(), time.Now())
-			if err != nil {
+			err = t.closeTriggered(ctx, notify, time.Now().Unix(), time.Now())
+			if err != nil && !errors.Is(err, model.ErrPositionClosed) {
+				t.listenersRepository.RearmListeners(notify)
+			}
+			if err != nil && !closeRejected(err) {
 				errChan <- err
 			}
 		}
@@ -382,10 +1051,28 @@ func closePositionListenerPNL(ctx context.Context, t *Trading, errChan chan erro
 				errChan <- fmt.Errorf("trading - closePositionListenerPNL - ClosePosition: %w", err)
 				continue
 			}
-			err = t.ClosePosition(ctx, notify.ID, time.Now().Unix(), time.Now())
-			if err != nil {
+			err = t.liquidatePosition(ctx, notify, time.Now().Unix(), time.Now())
+			if err != nil && !closeRejected(err) {
 				errChan <- err
 			}
 		}
 	}
 }
+
+func trailingLevelListener(ctx context.Context, t *Trading, errChan chan error) {
+	for {
+		select {
+		case <-ctx.Done():
+		default:
+			position, err := t.listenersRepository.TrailingLevel(ctx)
+			if err != nil {
+				errChan <- fmt.Errorf("trading - trailingLevelListener - TrailingLevel: %w", err)
+				continue
+			}
+			err = t.positionsRepository.SetTrailingLevel(ctx, position.ID, position.TrailingLevel, time.Now())
+			if err != nil {
+				errChan <- fmt.Errorf("trading - trailingLevelListener - SetTrailingLevel: %w", err)
+			}
+		}
+	}
+}
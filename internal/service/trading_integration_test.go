@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"github.com/OVantsevich/Trading-Service/internal/config"
 	"github.com/OVantsevich/Trading-Service/internal/model"
 	"github.com/OVantsevich/Trading-Service/internal/repository"
 	"github.com/OVantsevich/Trading-Service/internal/service/mocks"
@@ -38,24 +39,31 @@ func TestTrading_Listeners(t *testing.T) {
 		priceSlice[i] = price[position[i].Name]
 	}
 
-	paymentService.On("IncreaseAmount", mock.AnythingOfType(""), mock.AnythingOfType("string"), mock.AnythingOfType("float64")).Maybe().Return(nil)
+	paymentService.On("IncreaseAmount", mock.AnythingOfType(""), mock.AnythingOfType("string"), mock.AnythingOfType("float64"), mock.AnythingOfType("string")).Maybe().Return(nil)
 	paymentService.On("GetAccountID", mock.AnythingOfType(""), mock.AnythingOfType("string")).Maybe().Return("", nil)
-	paymentService.On("DecreaseAmount", mock.AnythingOfType(""), mock.AnythingOfType("string"), mock.AnythingOfType("float64")).Maybe().Return(nil)
+	paymentService.On("DecreaseAmount", mock.AnythingOfType(""), mock.AnythingOfType("string"), mock.AnythingOfType("float64"), mock.AnythingOfType("string")).Maybe().Return(nil)
+	paymentService.On("GetBalance", mock.AnythingOfType(""), mock.AnythingOfType("string")).Maybe().Return(0.0, nil)
 
 	until := make(chan time.Time)
 	priceService.On("GetPrices").Maybe().WaitUntil(until).Return(priceSlice, nil)
 
-	pnlListener := repository.NewPNLListenersRepository()
+	pnlListener := repository.NewPNLListenersRepository(repository.LiquidateWorstPNL)
 	listenerSLTP := repository.NewListenersRepository()
 
 	ctx, cancel := context.WithCancel(context.Background())
-	testTradingService = NewTrading(ctx, listenerSLTP, pnlListener, testPositionRepository, priceService, paymentService, repository.NewPgxTransactor(testPool))
+	cfg, err := config.NewMainConfig()
+	require.NoError(t, err)
+	testTradingService = NewTrading(ctx, cfg, listenerSLTP, pnlListener, repository.NewOrdersListenersRepository(), testPositionRepository,
+		repository.NewOrderRepository(repository.NewPgxWithinTransactionRunner(testPool)), repository.NewIdempotencyRepository(repository.NewPgxWithinTransactionRunner(testPool)),
+		repository.NewPaymentOutboxRepository(repository.NewPgxWithinTransactionRunner(testPool)), repository.NewEventsRepository(),
+		repository.NewCommissionRepository(repository.NewPgxWithinTransactionRunner(testPool)), repository.NewFinancingRepository(repository.NewPgxWithinTransactionRunner(testPool)),
+		repository.NewQuoteRepository(repository.NewPgxWithinTransactionRunner(testPool)),
+		repository.NewRiskRepository(repository.NewPgxWithinTransactionRunner(testPool)), priceService, paymentService, repository.NewPgxTransactor(testPool))
 
 	priceService.On("GetCurrentPrices", mock.AnythingOfType(""), mock.AnythingOfType("[]string")).Maybe().Return(
 		price,
 		nil,
 	)
-	var err error
 	for _, p := range position {
 		_, err = testTradingService.positionsRepository.CreatePosition(ctx, p)
 		require.NoError(t, err)
@@ -139,3 +147,124 @@ func TestTrading_Listeners(t *testing.T) {
 
 	cancel()
 }
+
+func TestTrading_RestoreListeners(t *testing.T) {
+	priceService := mocks.NewPriceService(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	position := &model.Position{
+		ID:            uuid.NewString(),
+		User:          uuid.NewString(),
+		Name:          uuid.NewString(),
+		Amount:        100,
+		PurchasePrice: 30,
+		Leverage:      1,
+		Created:       time.Now(),
+		Updated:       time.Now(),
+	}
+	_, err := testPositionRepository.CreatePosition(ctx, position)
+	require.NoError(t, err)
+	err = testPositionRepository.SetStopLoss(ctx, position.ID, 20, time.Now())
+	require.NoError(t, err)
+	_, err = testPositionRepository.SetStatus(ctx, position.ID, model.PositionClosing, time.Now())
+	require.NoError(t, err)
+
+	priceService.On("GetCurrentPrices", mock.AnythingOfType(""), mock.AnythingOfType("[]string")).Return(
+		map[string]*model.Price{position.Name: {Name: position.Name, SellingPrice: 30, PurchasePrice: 30}},
+		nil,
+	)
+	priceService.On("UpdateSubscription", mock.MatchedBy(func(names []string) bool {
+		for _, n := range names {
+			if n == position.Name {
+				return true
+			}
+		}
+		return false
+	})).Return(nil).Once()
+
+	listenerSLTP := repository.NewListenersRepository()
+	trading := &Trading{
+		positionsRepository: testPositionRepository,
+		priceService:        priceService,
+		listenersRepository: listenerSLTP,
+		listenerPNL:         repository.NewPNLListenersRepository(repository.LiquidateWorstPNL),
+		ordersRepository:    repository.NewOrderRepository(repository.NewPgxWithinTransactionRunner(testPool)),
+		ordersListeners:     repository.NewOrdersListenersRepository(),
+	}
+	err = trading.RestoreListeners(ctx)
+	require.NoError(t, err)
+	restored, err := testPositionRepository.GetPositionByID(ctx, position.ID)
+	require.NoError(t, err)
+	require.Equal(t, model.PositionPending, restored.Status)
+
+	listenerSLTP.SendPrices([]*model.Price{{Name: position.Name, SellingPrice: 10, PurchasePrice: 10}})
+	closed, err := listenerSLTP.ClosePosition(ctx)
+	require.NoError(t, err)
+	require.Equal(t, position.ID, closed.ID)
+
+	_, err = testPositionRepository.ClosePosition(ctx, &model.Position{ID: position.ID, Status: model.PositionClosed, Closed: time.Now().Unix(), SellingPrice: 10, CloseReason: model.CloseManual, Updated: time.Now()})
+	require.NoError(t, err)
+}
+
+func TestTrading_ClosePosition_InFlight(t *testing.T) {
+	ctx := context.Background()
+	position := &model.Position{
+		ID:            uuid.NewString(),
+		User:          uuid.NewString(),
+		Name:          uuid.NewString(),
+		Amount:        1,
+		PurchasePrice: 30,
+		Leverage:      1,
+		Created:       time.Now(),
+		Updated:       time.Now(),
+	}
+	_, err := testPositionRepository.CreatePosition(ctx, position)
+	require.NoError(t, err)
+	_, err = testPositionRepository.SetStatus(ctx, position.ID, model.PositionClosing, time.Now())
+	require.NoError(t, err)
+
+	trading := &Trading{positionsRepository: testPositionRepository}
+	err = trading.ClosePosition(ctx, position.ID, nil, time.Now().Unix(), time.Now())
+	require.ErrorIs(t, err, model.ErrPositionClosing)
+
+	_, err = testPositionRepository.ClosePosition(ctx, &model.Position{ID: position.ID, Status: model.PositionClosed, Closed: time.Now().Unix(), SellingPrice: 30, CloseReason: model.CloseManual, Updated: time.Now()})
+	require.NoError(t, err)
+	err = trading.ClosePosition(ctx, position.ID, nil, time.Now().Unix(), time.Now())
+	require.ErrorIs(t, err, model.ErrPositionClosed)
+}
+
+func TestTrading_GetPortfolioSummary(t *testing.T) {
+	priceService := mocks.NewPriceService(t)
+	ctx := context.Background()
+	user := uuid.NewString()
+	long := &model.Position{ID: uuid.NewString(), User: user, Name: "summaryA", Amount: 2, PurchasePrice: 100, Leverage: 1, Created: time.Now(), Updated: time.Now()}
+	short := &model.Position{ID: uuid.NewString(), User: user, Name: "summaryC", Amount: 1, PurchasePrice: 100, ShortPosition: true, Leverage: 1, Created: time.Now(), Updated: time.Now()}
+	closed := &model.Position{ID: uuid.NewString(), User: user, Name: "summaryB", Amount: 1, PurchasePrice: 100, Leverage: 1, Created: time.Now(), Updated: time.Now()}
+	for _, p := range []*model.Position{long, short, closed} {
+		_, err := testPositionRepository.CreatePosition(ctx, p)
+		require.NoError(t, err)
+	}
+	_, err := testPositionRepository.ClosePosition(ctx, &model.Position{ID: closed.ID, Status: model.PositionClosed, Closed: time.Now().Unix(),
+		SellingPrice: 105, CloseReason: model.CloseManual, RealizedPNL: 5, Updated: time.Now()})
+	require.NoError(t, err)
+
+	priceService.On("GetCurrentPrices", mock.AnythingOfType(""), mock.AnythingOfType("[]string")).Return(
+		map[string]*model.Price{
+			long.Name:  {Name: long.Name, SellingPrice: 110},
+			short.Name: {Name: short.Name, SellingPrice: 110},
+		},
+		nil,
+	).Once()
+
+	trading := &Trading{positionsRepository: testPositionRepository, priceService: priceService}
+	summary, err := trading.GetPortfolioSummary(ctx, user)
+	require.NoError(t, err)
+	require.Equal(t, 2, summary.OpenPositions)
+	require.Equal(t, 5.0, summary.RealizedPNL)
+	require.Equal(t, 10.0, summary.UnrealizedPNL)
+	require.Equal(t, 330.0, summary.GrossExposure)
+	require.Equal(t, 110.0, summary.NetExposure)
+	require.Len(t, summary.Instruments, 3)
+	require.Equal(t, -110.0, summary.Instruments[2].NetExposure)
+}
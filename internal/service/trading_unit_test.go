@@ -0,0 +1,283 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/OVantsevich/Trading-Service/internal/model"
+	"github.com/OVantsevich/Trading-Service/internal/repository"
+	"github.com/OVantsevich/Trading-Service/internal/service/mocks"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type tradingMocks struct {
+	positions   *mocks.PositionsRepository
+	prices      *mocks.PriceService
+	outbox      *mocks.PaymentOutboxRepository
+	commissions *mocks.CommissionRepository
+	financing   *mocks.FinancingRepository
+	quotes      *mocks.QuotesRepository
+	risk        *mocks.RiskRepository
+	orders      *mocks.OrdersRepository
+	ordersLis   *mocks.OrdersListenersRepository
+	idempotency *mocks.IdempotencyRepository
+	payments    *mocks.PaymentService
+	pnl         *mocks.ListenerPNL
+}
+
+// newMockedTrading trading service over mocks, transactions run the given function as is
+func newMockedTrading(t *testing.T) (*Trading, *tradingMocks) {
+	m := &tradingMocks{
+		positions:   mocks.NewPositionsRepository(t),
+		prices:      mocks.NewPriceService(t),
+		outbox:      mocks.NewPaymentOutboxRepository(t),
+		commissions: mocks.NewCommissionRepository(t),
+		financing:   mocks.NewFinancingRepository(t),
+		quotes:      mocks.NewQuotesRepository(t),
+		risk:        mocks.NewRiskRepository(t),
+		orders:      mocks.NewOrdersRepository(t),
+		ordersLis:   mocks.NewOrdersListenersRepository(t),
+		idempotency: mocks.NewIdempotencyRepository(t),
+		payments:    mocks.NewPaymentService(t),
+		pnl:         mocks.NewListenerPNL(t),
+	}
+	m.risk.On("GetRiskLimits", mock.Anything, mock.Anything, mock.Anything).Maybe().Return(nil, nil)
+	m.prices.On("Stale").Maybe().Return(false)
+	transactor := mocks.NewPgxTransactor(t)
+	transactor.On("WithinTransaction", mock.Anything, mock.Anything).Maybe().Return(
+		func(ctx context.Context, txFn repository.TxFunc) error { return txFn(ctx) })
+	return &Trading{
+		positionsRepository:      m.positions,
+		priceService:             m.prices,
+		paymentOutbox:            m.outbox,
+		commissions:              m.commissions,
+		financing:                m.financing,
+		quotes:                   m.quotes,
+		risk:                     m.risk,
+		ordersRepository:         m.orders,
+		ordersListeners:          m.ordersLis,
+		idempotencyRepository:    m.idempotency,
+		idempotencyTimeout:       time.Minute,
+		paymentService:           m.payments,
+		listenerPNL:              m.pnl,
+		paymentMaxAttempts:       3,
+		paymentBackoffMax:        time.Minute,
+		paymentReconcileInterval: time.Hour,
+		quoteTTL:                 5 * time.Second,
+		financingRollover:        22 * time.Hour,
+		maxLeverage:              10,
+		maintenanceMarginRate:    0.05,
+		transactor:               transactor,
+	}, m
+}
+
+// payment match command paying signed amount for reason
+func payment(reason string, amount float64) interface{} {
+	return mock.MatchedBy(func(command *model.PaymentCommand) bool {
+		return command.Reason == reason && paymentAmount(command) == amount
+	})
+}
+
+func percentageCommission(value float64) *model.CommissionSchedule {
+	return &model.CommissionSchedule{ID: uuid.NewString(), Kind: model.CommissionPercentage, Value: value}
+}
+
+func TestTrading_RestoreListeners_Unpriced(t *testing.T) {
+	trading, m := newMockedTrading(t)
+	priced := &model.Position{ID: uuid.NewString(), User: uuid.NewString(), Name: "priced", Amount: 1, Status: model.PositionOpen}
+	unpriced := &model.Position{ID: uuid.NewString(), User: uuid.NewString(), Name: "unpriced", Amount: 1, Status: model.PositionOpen}
+	prices := map[string]*model.Price{"priced": {Name: "priced", SellingPrice: 100}}
+
+	m.positions.On("RestoreClosing", mock.Anything, mock.Anything).Return(nil)
+	m.positions.On("GetOpenPositions", mock.Anything).Return([]*model.Position{priced, unpriced}, nil)
+	m.orders.On("GetPendingOrders", mock.Anything).Return(nil, nil)
+	m.prices.On("GetCurrentPrices", mock.Anything, []string{"priced", "unpriced"}).Return(prices, nil)
+	m.payments.On("GetBalance", mock.Anything, priced.User).Return(0.0, nil)
+	m.pnl.On("UpdateBalance", priced.User, 0.0).Return(nil)
+	m.pnl.On("AddPositions", mock.Anything, []*model.Position{priced}, prices).Return(nil)
+	m.prices.On("UpdateSubscription", []string{"priced", "unpriced"}).Return(nil)
+
+	err := trading.RestoreListeners(context.Background())
+	require.NoError(t, err)
+}
+
+func TestTrading_ClosePosition_NoPrice(t *testing.T) {
+	trading, m := newMockedTrading(t)
+	position := &model.Position{ID: uuid.NewString(), User: uuid.NewString(), Name: "unpriced", Amount: 2, PurchasePrice: 100,
+		Status: model.PositionOpen}
+
+	m.positions.On("GetPositionByID", mock.Anything, position.ID).Return(position, nil)
+	m.prices.On("GetCurrentPrices", mock.Anything, []string{position.Name}).Return(map[string]*model.Price{}, nil)
+
+	err := trading.PartialClosePosition(context.Background(), position.ID, 1, nil, time.Now())
+	require.ErrorContains(t, err, "no price of unpriced")
+
+	m.positions.On("SetStatus", mock.Anything, position.ID, model.PositionClosing, mock.Anything).Return(model.PositionOpen, nil).Once()
+	m.positions.On("RestoreStatus", mock.Anything, position.ID, mock.Anything).Return(nil).Once()
+	err = trading.ClosePosition(context.Background(), position.ID, nil, time.Now().Unix(), time.Now())
+	require.ErrorContains(t, err, "no price of unpriced")
+}
+
+func TestTrading_IncreasePosition(t *testing.T) {
+	trading, m := newMockedTrading(t)
+	position := &model.Position{ID: uuid.NewString(), User: uuid.NewString(), Name: "increase", Amount: 10, PurchasePrice: 100,
+		Leverage: 2, Margin: 500, MaintenanceMargin: 50, Status: model.PositionOpen}
+
+	m.positions.On("GetPositionByID", mock.Anything, position.ID).Return(position, nil)
+	m.prices.On("GetCurrentPrices", mock.Anything, []string{position.Name}).Return(
+		map[string]*model.Price{position.Name: {Name: position.Name, PurchasePrice: 130, SellingPrice: 129}}, nil)
+	m.commissions.On("GetCommissionSchedule", mock.Anything, position.User, position.Name).Return(nil, nil)
+	m.positions.On("UpdatePosition", mock.Anything, mock.MatchedBy(func(pos *model.Position) bool {
+		return pos.Amount == 40 && pos.PurchasePrice == 122.5 && pos.Margin == 2450 && pos.MaintenanceMargin == 245
+	})).Return(nil)
+	m.outbox.On("CreatePaymentCommand", mock.Anything, payment(model.PaymentScale, -1950)).Return(nil)
+
+	pos, err := trading.IncreasePosition(context.Background(), position.ID, 30, time.Now())
+	require.NoError(t, err)
+	require.Equal(t, 122.5, pos.PurchasePrice)
+
+	m.prices.ExpectedCalls = nil
+	m.prices.On("Stale").Return(false)
+	m.prices.On("GetCurrentPrices", mock.Anything, []string{position.Name}).Return(map[string]*model.Price{}, nil)
+	_, err = trading.IncreasePosition(context.Background(), position.ID, 1, time.Now())
+	require.Error(t, err)
+}
+
+func TestTrading_CreatePosition_Leverage(t *testing.T) {
+	tests := []struct {
+		name              string
+		leverage          float64
+		margin            float64
+		maintenanceMargin float64
+		err               bool
+	}{
+		{name: "default", leverage: 0, margin: 1000, maintenanceMargin: 50},
+		{name: "leveraged", leverage: 4, margin: 250, maintenanceMargin: 50},
+		{name: "max", leverage: 10, margin: 100, maintenanceMargin: 50},
+		{name: "below one", leverage: 0.5, err: true},
+		{name: "above max", leverage: 11, err: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			trading, m := newMockedTrading(t)
+			position := &model.Position{User: uuid.NewString(), Name: "leverage", Amount: 10, Leverage: tt.leverage}
+
+			m.prices.On("GetCurrentPrices", mock.Anything, []string{position.Name}).Return(
+				map[string]*model.Price{position.Name: {Name: position.Name, PurchasePrice: 100, SellingPrice: 99}}, nil)
+			if !tt.err {
+				m.commissions.On("GetCommissionSchedule", mock.Anything, position.User, position.Name).Return(nil, nil)
+				m.positions.On("CreatePosition", mock.Anything, mock.MatchedBy(func(pos *model.Position) bool {
+					return pos.Margin == tt.margin && pos.MaintenanceMargin == tt.maintenanceMargin && pos.Leverage >= 1
+				})).Return(func(_ context.Context, pos *model.Position) *model.Position { return pos }, nil)
+				m.outbox.On("CreatePaymentCommand", mock.Anything, payment(model.PaymentOpen, -tt.margin)).Return(nil)
+			}
+
+			_, err := trading.CreatePosition(context.Background(), position, nil)
+			if tt.err {
+				require.ErrorContains(t, err, "leverage must be between 1 and 10")
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestTrading_CreatePosition_Bracket(t *testing.T) {
+	tests := []struct {
+		name       string
+		short      bool
+		stopLoss   float64
+		takeProfit float64
+		err        string
+	}{
+		{name: "long", stopLoss: 90, takeProfit: 110},
+		{name: "short", short: true, stopLoss: 110, takeProfit: 90},
+		{name: "long stop loss above price", stopLoss: 101, err: "stop loss 101 would be triggered at current price 100"},
+		{name: "long take profit below price", stopLoss: 90, takeProfit: 99, err: "take profit 99 would be triggered at current price 100"},
+		{name: "short stop loss below price", short: true, stopLoss: 99, err: "stop loss 99 would be triggered at current price 100"},
+		{name: "short take profit above price", short: true, takeProfit: 101, err: "take profit 101 would be triggered at current price 100"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			trading, m := newMockedTrading(t)
+			position := &model.Position{User: uuid.NewString(), Name: "bracket", Amount: 1, ShortPosition: tt.short,
+				StopLoss: tt.stopLoss, TakeProfit: tt.takeProfit}
+
+			m.prices.On("GetCurrentPrices", mock.Anything, []string{position.Name}).Return(
+				map[string]*model.Price{position.Name: {Name: position.Name, PurchasePrice: 101, SellingPrice: 100}}, nil)
+			m.commissions.On("GetCommissionSchedule", mock.Anything, position.User, position.Name).Return(nil, nil)
+			m.positions.On("CreatePosition", mock.Anything, mock.Anything).Return(
+				func(_ context.Context, pos *model.Position) *model.Position { return pos }, nil)
+			m.positions.On("SetStopLoss", mock.Anything, mock.Anything, tt.stopLoss, mock.Anything).Maybe().Return(nil)
+			m.positions.On("SetTakeProfit", mock.Anything, mock.Anything, tt.takeProfit, mock.Anything).Maybe().Return(nil)
+
+			if tt.err != "" {
+				_, err := trading.CreatePosition(context.Background(), position, nil)
+				require.ErrorContains(t, err, tt.err)
+				m.outbox.AssertNotCalled(t, "CreatePaymentCommand", mock.Anything, mock.Anything)
+				return
+			}
+			m.outbox.On("CreatePaymentCommand", mock.Anything, mock.Anything).Return(nil)
+			_, err := trading.CreatePosition(context.Background(), position, nil)
+			require.NoError(t, err)
+			m.positions.AssertCalled(t, "SetStopLoss", mock.Anything, mock.Anything, tt.stopLoss, mock.Anything)
+			m.positions.AssertCalled(t, "SetTakeProfit", mock.Anything, mock.Anything, tt.takeProfit, mock.Anything)
+		})
+	}
+}
+
+func TestTrading_StalePrices(t *testing.T) {
+	trading, m := newMockedTrading(t)
+	m.prices.ExpectedCalls = nil
+	m.prices.On("Stale").Return(true)
+
+	_, err := trading.CreatePosition(context.Background(), &model.Position{User: uuid.NewString(), Name: "stale", Amount: 1}, nil)
+	require.ErrorIs(t, err, model.ErrPricesStale)
+	_, err = trading.IncreasePosition(context.Background(), uuid.NewString(), 1, time.Now())
+	require.ErrorIs(t, err, model.ErrPricesStale)
+	_, err = trading.RequestQuote(context.Background(), &model.Quote{User: uuid.NewString(), Name: "stale", Amount: 1})
+	require.ErrorIs(t, err, model.ErrPricesStale)
+	m.prices.AssertNotCalled(t, "GetCurrentPrices", mock.Anything, mock.Anything)
+}
+
+func TestTrading_StreamPortfolio(t *testing.T) {
+	trading, m := newMockedTrading(t)
+	user := uuid.NewString()
+	portfolios := make(chan *model.Portfolio, 1)
+	portfolios <- &model.Portfolio{User: user, Balance: 70, Equity: 70}
+	unsubscribed := false
+
+	m.payments.On("GetBalance", mock.Anything, user).Return(70.0, nil).Once()
+	m.pnl.On("UpdateBalance", user, 70.0).Return(nil).Once()
+	m.pnl.On("SubscribePortfolio", user).Return((<-chan *model.Portfolio)(portfolios), func() { unsubscribed = true })
+
+	var sent *model.Portfolio
+	err := trading.StreamPortfolio(context.Background(), user, func(portfolio *model.Portfolio) error {
+		sent = portfolio
+		return errors.New("stream closed")
+	})
+	require.Error(t, err)
+	require.Equal(t, 70.0, sent.Balance)
+	require.True(t, unsubscribed)
+}
+
+func TestTrading_GetPortfolioSummary_NoPrice(t *testing.T) {
+	trading, m := newMockedTrading(t)
+	user := uuid.NewString()
+	positions := []*model.Position{
+		{ID: uuid.NewString(), User: user, Name: "priced", Amount: 1, PurchasePrice: 100},
+		{ID: uuid.NewString(), User: user, Name: "unpriced", Amount: 1, PurchasePrice: 100},
+	}
+
+	m.positions.On("GetUserPositions", mock.Anything, user).Return(positions, nil)
+	m.prices.On("GetCurrentPrices", mock.Anything, []string{"priced", "unpriced"}).Return(
+		map[string]*model.Price{"priced": {Name: "priced", SellingPrice: 110}}, nil)
+
+	_, err := trading.GetPortfolioSummary(context.Background(), user)
+	require.ErrorContains(t, err, "no price of unpriced")
+}
@@ -3,8 +3,10 @@ package main
 
 import (
 	"context"
+	_ "expvar"
 	"fmt"
 	"net"
+	"net/http"
 
 	"github.com/OVantsevich/Trading-Service/internal/config"
 	"github.com/OVantsevich/Trading-Service/internal/handler"
@@ -32,6 +34,12 @@ func main() {
 		defer logrus.Fatalf("error while listening port: %v", err)
 	}
 
+	go func() {
+		if err := http.ListenAndServe(fmt.Sprintf("%s:%s", cfg.Host, cfg.MetricsPort), nil); err != nil {
+			logrus.Errorf("error while serving metrics: %v", err)
+		}
+	}()
+
 	pool, err := dbConnection(cfg)
 	if err != nil {
 		logrus.Fatal(err)
@@ -50,7 +58,7 @@ func main() {
 		logrus.Fatal("Fatal Dial: ", err)
 	}
 	prsClient := prsProto.NewPriceServiceClient(connPrice)
-	priceService, err := repository.NewPriceServiceRepository(ctx, prsClient)
+	priceService, err := repository.NewPriceServiceRepository(ctx, prsClient, cfg.PriceReconnectBackoffMax)
 	if err != nil {
 		logrus.Fatal(err)
 	}
@@ -63,9 +71,23 @@ func main() {
 	paymentService := repository.NewPaymentServiceRepository(pasClient)
 
 	listenerRepository := repository.NewListenersRepository()
-	pnlListener := repository.NewPNLListenersRepository()
-
-	tradingService := service.NewTrading(ctx, listenerRepository, pnlListener, positionRepository, priceService, paymentService, repository.NewPgxTransactor(pool))
+	pnlListener := repository.NewPNLListenersRepository(cfg.LiquidationPolicy)
+	ordersListener := repository.NewOrdersListenersRepository()
+	orderRepository := repository.NewOrderRepository(repository.NewPgxWithinTransactionRunner(pool))
+	idempotencyRepository := repository.NewIdempotencyRepository(repository.NewPgxWithinTransactionRunner(pool))
+	paymentOutbox := repository.NewPaymentOutboxRepository(repository.NewPgxWithinTransactionRunner(pool))
+	events := repository.NewEventsRepository()
+	commissions := repository.NewCommissionRepository(repository.NewPgxWithinTransactionRunner(pool))
+	financing := repository.NewFinancingRepository(repository.NewPgxWithinTransactionRunner(pool))
+	quotes := repository.NewQuoteRepository(repository.NewPgxWithinTransactionRunner(pool))
+	risk := repository.NewRiskRepository(repository.NewPgxWithinTransactionRunner(pool))
+
+	tradingService := service.NewTrading(ctx, cfg, listenerRepository, pnlListener, ordersListener, positionRepository, orderRepository,
+		idempotencyRepository, paymentOutbox, events, commissions, financing, quotes, risk, priceService, paymentService, repository.NewPgxTransactor(pool))
+	err = tradingService.RestoreListeners(ctx)
+	if err != nil {
+		logrus.Fatal(err)
+	}
 	tradingServer := handler.NewPrice(tradingService)
 
 	ns := grpc.NewServer()
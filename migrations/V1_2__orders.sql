@@ -0,0 +1,58 @@
+create table if not exists orders
+(
+    id             varchar(200)
+        constraint Orders_pk
+            primary key,
+    "user"         varchar(200)                                  not null,
+    "name"         varchar(50)                                   not null,
+    amount         double precision                              not null,
+    price          double precision                              not null,
+    short_position boolean          default false                not null,
+    status         varchar(20)      default 'pending'            not null,
+    position_id    varchar(200)     default ''                   not null,
+    created        timestamp(6)     default CURRENT_TIMESTAMP(6) not null,
+    updated        timestamp(6)     default CURRENT_TIMESTAMP(6) not null
+);
+
+alter table orders
+    owner to postgres;
+
+create index if not exists orders_user_index
+    on orders ("user");
+
+CREATE OR REPLACE FUNCTION notify_order() RETURNS TRIGGER AS
+$BODY$
+DECLARE
+    payload jsonb;
+BEGIN
+    payload = jsonb_build_object(
+            'order', jsonb_build_object(
+                    'id', to_jsonb(NEW.id),
+                    'name', to_jsonb(NEW.name),
+                    'user', to_jsonb(NEW.user),
+                    'amount', to_jsonb(NEW.amount),
+                    'price', to_jsonb(NEW.price),
+                    'short_position', to_jsonb(NEW.short_position),
+                    'status', to_jsonb(NEW.status)
+                ),
+            'type', to_jsonb(TG_NAME)
+        );
+
+    PERFORM pg_notify('thresholds', payload::TEXT);
+
+    RETURN NEW;
+END ;
+$BODY$ LANGUAGE plpgsql;
+
+CREATE TRIGGER order_placed
+    AFTER INSERT
+    ON orders
+    FOR EACH ROW
+EXECUTE FUNCTION notify_order();
+
+CREATE TRIGGER order_closed
+    AFTER UPDATE OF status
+    ON orders
+    FOR EACH ROW
+    WHEN (OLD.status = 'pending' AND NEW.status <> 'pending')
+EXECUTE FUNCTION notify_order();
@@ -0,0 +1,51 @@
+alter table positions
+    add column if not exists trailing_stop       double precision default 0     not null,
+    add column if not exists trailing_percentage boolean          default false not null,
+    add column if not exists trailing_level      double precision default 0     not null;
+
+CREATE OR REPLACE FUNCTION notify() RETURNS TRIGGER AS
+$BODY$
+DECLARE
+    payload jsonb;
+BEGIN
+    payload = jsonb_build_object(
+            'id', to_jsonb(NEW.id),
+            'name', to_jsonb(NEW.name),
+            'user', to_jsonb(NEW.user),
+            'purchase_price', to_jsonb(NEW.purchase_price),
+            'selling_price', to_jsonb(NEW.selling_price),
+            'short_position', to_jsonb(NEW.short_position),
+            'type', to_jsonb(TG_NAME)
+        );
+    IF TG_NAME = 'stop_loss' THEN
+        payload = jsonb_set(payload, '{stop_loss}', to_jsonb(NEW.stop_loss), true);
+    END IF;
+    IF TG_NAME = 'take_profit' THEN
+        payload = jsonb_set(payload, '{take_profit}', to_jsonb(NEW.take_profit), true);
+    END IF;
+    IF TG_NAME = 'trailing_stop' THEN
+        payload = jsonb_set(payload, '{trailing_stop}', to_jsonb(NEW.trailing_stop), true);
+        payload = jsonb_set(payload, '{trailing_percentage}', to_jsonb(NEW.trailing_percentage), true);
+        payload = jsonb_set(payload, '{trailing_level}', to_jsonb(NEW.trailing_level), true);
+    END IF;
+    IF TG_NAME = 'closed' THEN
+        payload = jsonb_set(payload, '{closed}', to_jsonb(NEW.closed), true);
+        payload = jsonb_set(payload, '{stop_loss}', to_jsonb(OLD.stop_loss), true);
+        payload = jsonb_set(payload, '{take_profit}', to_jsonb(OLD.take_profit), true);
+        payload = jsonb_set(payload, '{trailing_stop}', to_jsonb(OLD.trailing_stop), true);
+    END IF;
+    IF TG_NAME = 'created' THEN
+        payload = jsonb_set(payload, '{amount}', to_jsonb(NEW.amount), true);
+    END IF;
+
+    PERFORM pg_notify('thresholds', payload::TEXT);
+
+    RETURN NEW;
+END ;
+$BODY$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trailing_stop
+    AFTER UPDATE OF trailing_stop, trailing_percentage
+    ON positions
+    FOR EACH ROW
+EXECUTE FUNCTION notify();
@@ -0,0 +1,66 @@
+create table if not exists position_fills
+(
+    id          varchar(200)
+        constraint Position_fills_pk
+            primary key,
+    position_id varchar(200)                                  not null
+        constraint position_fills_positions_id_fk
+            references positions,
+    amount      double precision                              not null,
+    price       double precision                              not null,
+    created     timestamp(6)     default CURRENT_TIMESTAMP(6) not null
+);
+
+alter table position_fills
+    owner to postgres;
+
+create index if not exists position_fills_position_id_index
+    on position_fills (position_id);
+
+CREATE OR REPLACE FUNCTION notify() RETURNS TRIGGER AS
+$BODY$
+DECLARE
+    payload jsonb;
+BEGIN
+    payload = jsonb_build_object(
+            'id', to_jsonb(NEW.id),
+            'name', to_jsonb(NEW.name),
+            'user', to_jsonb(NEW.user),
+            'purchase_price', to_jsonb(NEW.purchase_price),
+            'selling_price', to_jsonb(NEW.selling_price),
+            'short_position', to_jsonb(NEW.short_position),
+            'type', to_jsonb(TG_NAME)
+        );
+    IF TG_NAME = 'stop_loss' THEN
+        payload = jsonb_set(payload, '{stop_loss}', to_jsonb(NEW.stop_loss), true);
+    END IF;
+    IF TG_NAME = 'take_profit' THEN
+        payload = jsonb_set(payload, '{take_profit}', to_jsonb(NEW.take_profit), true);
+    END IF;
+    IF TG_NAME = 'trailing_stop' THEN
+        payload = jsonb_set(payload, '{trailing_stop}', to_jsonb(NEW.trailing_stop), true);
+        payload = jsonb_set(payload, '{trailing_percentage}', to_jsonb(NEW.trailing_percentage), true);
+        payload = jsonb_set(payload, '{trailing_level}', to_jsonb(NEW.trailing_level), true);
+    END IF;
+    IF TG_NAME = 'closed' THEN
+        payload = jsonb_set(payload, '{closed}', to_jsonb(NEW.closed), true);
+        payload = jsonb_set(payload, '{stop_loss}', to_jsonb(OLD.stop_loss), true);
+        payload = jsonb_set(payload, '{take_profit}', to_jsonb(OLD.take_profit), true);
+        payload = jsonb_set(payload, '{trailing_stop}', to_jsonb(OLD.trailing_stop), true);
+    END IF;
+    IF TG_NAME = 'created' OR TG_NAME = 'amount_changed' THEN
+        payload = jsonb_set(payload, '{amount}', to_jsonb(NEW.amount), true);
+    END IF;
+
+    PERFORM pg_notify('thresholds', payload::TEXT);
+
+    RETURN NEW;
+END ;
+$BODY$ LANGUAGE plpgsql;
+
+CREATE TRIGGER amount_changed
+    AFTER UPDATE OF amount
+    ON positions
+    FOR EACH ROW
+    WHEN (OLD.amount IS DISTINCT FROM NEW.amount)
+EXECUTE FUNCTION notify();
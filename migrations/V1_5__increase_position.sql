@@ -0,0 +1,8 @@
+DROP TRIGGER IF EXISTS amount_changed ON positions;
+
+CREATE TRIGGER amount_changed
+    AFTER UPDATE OF amount, purchase_price
+    ON positions
+    FOR EACH ROW
+    WHEN (OLD.amount IS DISTINCT FROM NEW.amount OR OLD.purchase_price IS DISTINCT FROM NEW.purchase_price)
+EXECUTE FUNCTION notify();
@@ -0,0 +1,13 @@
+create table if not exists idempotency_keys
+(
+    key          varchar(200)
+        constraint Idempotency_keys_pk
+            primary key,
+    method       varchar(100)                                  not null,
+    request_hash bytea                                         not null,
+    response     bytea,
+    created      timestamp(6)     default CURRENT_TIMESTAMP(6) not null
+);
+
+alter table idempotency_keys
+    owner to postgres;
@@ -0,0 +1,30 @@
+create table if not exists payment_outbox
+(
+    id           varchar(200)
+        constraint Payment_outbox_pk
+            primary key,
+    seq          bigserial                                     not null,
+    position_id  varchar(200)                                  not null,
+    "user"       varchar(200)                                  not null,
+    operation    varchar(20)                                   not null,
+    amount       double precision                              not null,
+    reason       varchar(20)                                   not null,
+    snapshot     jsonb,
+    status       varchar(20)      default 'pending'            not null,
+    attempts     integer          default 0                    not null,
+    last_error   text             default ''                   not null,
+    next_attempt timestamp(6)     default CURRENT_TIMESTAMP(6) not null,
+    created      timestamp(6)     default CURRENT_TIMESTAMP(6) not null,
+    updated      timestamp(6)     default CURRENT_TIMESTAMP(6) not null
+);
+
+alter table payment_outbox
+    owner to postgres;
+
+create index if not exists payment_outbox_pending_index
+    on payment_outbox (position_id, seq)
+    where status in ('pending', 'in_flight');
+
+create index if not exists payment_outbox_due_index
+    on payment_outbox (next_attempt)
+    where status in ('pending', 'in_flight', 'reconcile');
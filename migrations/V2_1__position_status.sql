@@ -0,0 +1,12 @@
+alter table positions
+    add column if not exists status varchar(20) default 'open' not null;
+
+update positions
+set status = case when liquidation_reason = 'payment_failed' then 'failed' else 'closed' end
+where closed != 0;
+
+create index if not exists positions_status_index
+    on positions (status);
+
+alter table positions
+    add column if not exists previous_status varchar(20) default '' not null;
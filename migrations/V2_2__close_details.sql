@@ -0,0 +1,14 @@
+alter table positions
+    add column if not exists close_reason  varchar(20)      default '' not null,
+    add column if not exists trigger_price double precision default 0  not null,
+    add column if not exists realized_pnl  double precision default 0  not null;
+
+update positions
+set close_reason = case
+                       when liquidation_reason = 'payment_failed' then 'payment_failed'
+                       when liquidation_reason != '' then 'liquidation'
+                       else 'manual' end,
+    realized_pnl = case
+                       when short_position then amount * (purchase_price - selling_price)
+                       else amount * (selling_price - purchase_price) end
+where closed != 0;
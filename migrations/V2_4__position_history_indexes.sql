@@ -0,0 +1,5 @@
+create index if not exists positions_user_created_index
+    on positions ("user", created, id);
+
+create index if not exists positions_user_closed_created_index
+    on positions ("user", closed, created, id);
@@ -0,0 +1,84 @@
+create table if not exists commission_schedules
+(
+    id      varchar(200)
+        constraint Commission_schedules_pk
+            primary key,
+    "name"  varchar(50)      default ''                    not null,
+    tier    varchar(50)      default ''                    not null,
+    kind    varchar(20)                                    not null,
+    value   double precision                               not null,
+    created timestamp(6)     default CURRENT_TIMESTAMP(6)  not null
+);
+
+alter table commission_schedules
+    owner to postgres;
+
+create unique index if not exists commission_schedules_name_tier_uindex
+    on commission_schedules ("name", tier);
+
+create table if not exists user_tiers
+(
+    "user"  varchar(200)
+        constraint User_tiers_pk
+            primary key,
+    tier    varchar(50)                                    not null,
+    updated timestamp(6)     default CURRENT_TIMESTAMP(6)  not null
+);
+
+alter table user_tiers
+    owner to postgres;
+
+alter table positions
+    add column if not exists fees double precision default 0 not null;
+
+CREATE OR REPLACE FUNCTION notify() RETURNS TRIGGER AS
+$BODY$
+DECLARE
+    payload jsonb;
+BEGIN
+    payload = jsonb_build_object(
+            'id', to_jsonb(NEW.id),
+            'name', to_jsonb(NEW.name),
+            'user', to_jsonb(NEW.user),
+            'purchase_price', to_jsonb(NEW.purchase_price),
+            'selling_price', to_jsonb(NEW.selling_price),
+            'short_position', to_jsonb(NEW.short_position),
+            'leverage', to_jsonb(NEW.leverage),
+            'margin', to_jsonb(NEW.margin),
+            'maintenance_margin', to_jsonb(NEW.maintenance_margin),
+            'fees', to_jsonb(NEW.fees),
+            'type', to_jsonb(TG_NAME),
+            'xmin', to_jsonb(pg_snapshot_xmin(pg_current_snapshot())::text)
+        );
+    IF TG_NAME = 'stop_loss' THEN
+        payload = jsonb_set(payload, '{stop_loss}', to_jsonb(NEW.stop_loss), true);
+    END IF;
+    IF TG_NAME = 'take_profit' THEN
+        payload = jsonb_set(payload, '{take_profit}', to_jsonb(NEW.take_profit), true);
+    END IF;
+    IF TG_NAME = 'trailing_stop' THEN
+        payload = jsonb_set(payload, '{trailing_stop}', to_jsonb(NEW.trailing_stop), true);
+        payload = jsonb_set(payload, '{trailing_percentage}', to_jsonb(NEW.trailing_percentage), true);
+        payload = jsonb_set(payload, '{trailing_level}', to_jsonb(NEW.trailing_level), true);
+    END IF;
+    IF TG_NAME = 'closed' THEN
+        payload = jsonb_set(payload, '{closed}', to_jsonb(NEW.closed), true);
+        payload = jsonb_set(payload, '{status}', to_jsonb(NEW.status), true);
+        payload = jsonb_set(payload, '{close_reason}', to_jsonb(NEW.close_reason), true);
+        payload = jsonb_set(payload, '{trigger_price}', to_jsonb(NEW.trigger_price), true);
+        payload = jsonb_set(payload, '{realized_pnl}', to_jsonb(NEW.realized_pnl), true);
+        payload = jsonb_set(payload, '{liquidation_reason}', to_jsonb(NEW.liquidation_reason), true);
+        payload = jsonb_set(payload, '{amount}', to_jsonb(NEW.amount), true);
+        payload = jsonb_set(payload, '{stop_loss}', to_jsonb(OLD.stop_loss), true);
+        payload = jsonb_set(payload, '{take_profit}', to_jsonb(OLD.take_profit), true);
+        payload = jsonb_set(payload, '{trailing_stop}', to_jsonb(OLD.trailing_stop), true);
+    END IF;
+    IF TG_NAME = 'created' OR TG_NAME = 'amount_changed' THEN
+        payload = jsonb_set(payload, '{amount}', to_jsonb(NEW.amount), true);
+    END IF;
+
+    PERFORM pg_notify('thresholds', payload::TEXT);
+
+    RETURN NEW;
+END ;
+$BODY$ LANGUAGE plpgsql;
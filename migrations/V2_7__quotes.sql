@@ -0,0 +1,19 @@
+create table if not exists quotes
+(
+    id             varchar(200)
+        constraint Quotes_pk
+            primary key,
+    "user"         varchar(200)                                  not null,
+    "name"         varchar(50)                                   not null,
+    amount         double precision                              not null,
+    short_position boolean          default false                not null,
+    purchase_price double precision                              not null,
+    selling_price  double precision                              not null,
+    position_id    varchar(200)     default ''                   not null,
+    expires        timestamp(6)                                  not null,
+    executed       timestamp(6),
+    created        timestamp(6)     default CURRENT_TIMESTAMP(6) not null
+);
+
+alter table quotes
+    owner to postgres;
@@ -0,0 +1,15 @@
+create table if not exists risk_limits
+(
+    "user"             varchar(200)                                  not null,
+    "name"             varchar(50)      default ''                   not null,
+    max_open_positions integer          default 0                    not null,
+    max_notional       double precision default 0                    not null,
+    max_exposure       double precision default 0                    not null,
+    max_short_exposure double precision default 0                    not null,
+    updated            timestamp(6)     default CURRENT_TIMESTAMP(6) not null,
+    constraint Risk_limits_pk
+        primary key ("user", "name")
+);
+
+alter table risk_limits
+    owner to postgres;
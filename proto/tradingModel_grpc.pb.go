@@ -24,10 +24,25 @@ const _ = grpc.SupportPackageIsVersion7
 type TradingServiceClient interface {
 	OpenPosition(ctx context.Context, in *OpenPositionRequest, opts ...grpc.CallOption) (*OpenPositionResponse, error)
 	ClosePosition(ctx context.Context, in *ClosePositionRequest, opts ...grpc.CallOption) (*Response, error)
+	IncreasePosition(ctx context.Context, in *IncreasePositionRequest, opts ...grpc.CallOption) (*IncreasePositionResponse, error)
 	GetPositionByID(ctx context.Context, in *GetPositionByIDRequest, opts ...grpc.CallOption) (*GetPositionByIDResponse, error)
 	GetUserPositions(ctx context.Context, in *GetUserPositionsRequest, opts ...grpc.CallOption) (*GetUserPositionsResponse, error)
+	ListPositions(ctx context.Context, in *ListPositionsRequest, opts ...grpc.CallOption) (*ListPositionsResponse, error)
+	GetPortfolioSummary(ctx context.Context, in *GetPortfolioSummaryRequest, opts ...grpc.CallOption) (*GetPortfolioSummaryResponse, error)
 	StopLoss(ctx context.Context, in *StopLossRequest, opts ...grpc.CallOption) (*Response, error)
 	TakeProfit(ctx context.Context, in *TakeProfitRequest, opts ...grpc.CallOption) (*Response, error)
+	TrailingStop(ctx context.Context, in *TrailingStopRequest, opts ...grpc.CallOption) (*Response, error)
+	PlaceLimitOrder(ctx context.Context, in *PlaceLimitOrderRequest, opts ...grpc.CallOption) (*PlaceLimitOrderResponse, error)
+	CancelOrder(ctx context.Context, in *CancelOrderRequest, opts ...grpc.CallOption) (*Response, error)
+	GetUserOrders(ctx context.Context, in *GetUserOrdersRequest, opts ...grpc.CallOption) (*GetUserOrdersResponse, error)
+	StreamPortfolio(ctx context.Context, in *StreamPortfolioRequest, opts ...grpc.CallOption) (TradingService_StreamPortfolioClient, error)
+	SubscribeEvents(ctx context.Context, in *SubscribeEventsRequest, opts ...grpc.CallOption) (TradingService_SubscribeEventsClient, error)
+	SetCommissionSchedule(ctx context.Context, in *SetCommissionScheduleRequest, opts ...grpc.CallOption) (*Response, error)
+	SetUserTier(ctx context.Context, in *SetUserTierRequest, opts ...grpc.CallOption) (*Response, error)
+	SetFinancingRate(ctx context.Context, in *SetFinancingRateRequest, opts ...grpc.CallOption) (*Response, error)
+	RequestQuote(ctx context.Context, in *RequestQuoteRequest, opts ...grpc.CallOption) (*RequestQuoteResponse, error)
+	ExecuteQuote(ctx context.Context, in *ExecuteQuoteRequest, opts ...grpc.CallOption) (*ExecuteQuoteResponse, error)
+	SetRiskLimits(ctx context.Context, in *SetRiskLimitsRequest, opts ...grpc.CallOption) (*Response, error)
 }
 
 type tradingServiceClient struct {
@@ -56,6 +71,15 @@ func (c *tradingServiceClient) ClosePosition(ctx context.Context, in *ClosePosit
 	return out, nil
 }
 
+func (c *tradingServiceClient) IncreasePosition(ctx context.Context, in *IncreasePositionRequest, opts ...grpc.CallOption) (*IncreasePositionResponse, error) {
+	out := new(IncreasePositionResponse)
+	err := c.cc.Invoke(ctx, "/tradingservice_proto.TradingService/IncreasePosition", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *tradingServiceClient) GetPositionByID(ctx context.Context, in *GetPositionByIDRequest, opts ...grpc.CallOption) (*GetPositionByIDResponse, error) {
 	out := new(GetPositionByIDResponse)
 	err := c.cc.Invoke(ctx, "/tradingservice_proto.TradingService/GetPositionByID", in, out, opts...)
@@ -74,6 +98,24 @@ func (c *tradingServiceClient) GetUserPositions(ctx context.Context, in *GetUser
 	return out, nil
 }
 
+func (c *tradingServiceClient) ListPositions(ctx context.Context, in *ListPositionsRequest, opts ...grpc.CallOption) (*ListPositionsResponse, error) {
+	out := new(ListPositionsResponse)
+	err := c.cc.Invoke(ctx, "/tradingservice_proto.TradingService/ListPositions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tradingServiceClient) GetPortfolioSummary(ctx context.Context, in *GetPortfolioSummaryRequest, opts ...grpc.CallOption) (*GetPortfolioSummaryResponse, error) {
+	out := new(GetPortfolioSummaryResponse)
+	err := c.cc.Invoke(ctx, "/tradingservice_proto.TradingService/GetPortfolioSummary", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *tradingServiceClient) StopLoss(ctx context.Context, in *StopLossRequest, opts ...grpc.CallOption) (*Response, error) {
 	out := new(Response)
 	err := c.cc.Invoke(ctx, "/tradingservice_proto.TradingService/StopLoss", in, out, opts...)
@@ -92,16 +134,185 @@ func (c *tradingServiceClient) TakeProfit(ctx context.Context, in *TakeProfitReq
 	return out, nil
 }
 
+func (c *tradingServiceClient) TrailingStop(ctx context.Context, in *TrailingStopRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/tradingservice_proto.TradingService/TrailingStop", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tradingServiceClient) PlaceLimitOrder(ctx context.Context, in *PlaceLimitOrderRequest, opts ...grpc.CallOption) (*PlaceLimitOrderResponse, error) {
+	out := new(PlaceLimitOrderResponse)
+	err := c.cc.Invoke(ctx, "/tradingservice_proto.TradingService/PlaceLimitOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tradingServiceClient) CancelOrder(ctx context.Context, in *CancelOrderRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/tradingservice_proto.TradingService/CancelOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tradingServiceClient) GetUserOrders(ctx context.Context, in *GetUserOrdersRequest, opts ...grpc.CallOption) (*GetUserOrdersResponse, error) {
+	out := new(GetUserOrdersResponse)
+	err := c.cc.Invoke(ctx, "/tradingservice_proto.TradingService/GetUserOrders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tradingServiceClient) StreamPortfolio(ctx context.Context, in *StreamPortfolioRequest, opts ...grpc.CallOption) (TradingService_StreamPortfolioClient, error) {
+	stream, err := c.cc.NewStream(ctx, &TradingService_ServiceDesc.Streams[0], "/tradingservice_proto.TradingService/StreamPortfolio", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &tradingServiceStreamPortfolioClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type TradingService_StreamPortfolioClient interface {
+	Recv() (*StreamPortfolioResponse, error)
+	grpc.ClientStream
+}
+
+type tradingServiceStreamPortfolioClient struct {
+	grpc.ClientStream
+}
+
+func (x *tradingServiceStreamPortfolioClient) Recv() (*StreamPortfolioResponse, error) {
+	m := new(StreamPortfolioResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *tradingServiceClient) SubscribeEvents(ctx context.Context, in *SubscribeEventsRequest, opts ...grpc.CallOption) (TradingService_SubscribeEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &TradingService_ServiceDesc.Streams[1], "/tradingservice_proto.TradingService/SubscribeEvents", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &tradingServiceSubscribeEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type TradingService_SubscribeEventsClient interface {
+	Recv() (*Event, error)
+	grpc.ClientStream
+}
+
+type tradingServiceSubscribeEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *tradingServiceSubscribeEventsClient) Recv() (*Event, error) {
+	m := new(Event)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *tradingServiceClient) SetCommissionSchedule(ctx context.Context, in *SetCommissionScheduleRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/tradingservice_proto.TradingService/SetCommissionSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tradingServiceClient) SetUserTier(ctx context.Context, in *SetUserTierRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/tradingservice_proto.TradingService/SetUserTier", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tradingServiceClient) SetFinancingRate(ctx context.Context, in *SetFinancingRateRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/tradingservice_proto.TradingService/SetFinancingRate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tradingServiceClient) RequestQuote(ctx context.Context, in *RequestQuoteRequest, opts ...grpc.CallOption) (*RequestQuoteResponse, error) {
+	out := new(RequestQuoteResponse)
+	err := c.cc.Invoke(ctx, "/tradingservice_proto.TradingService/RequestQuote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tradingServiceClient) ExecuteQuote(ctx context.Context, in *ExecuteQuoteRequest, opts ...grpc.CallOption) (*ExecuteQuoteResponse, error) {
+	out := new(ExecuteQuoteResponse)
+	err := c.cc.Invoke(ctx, "/tradingservice_proto.TradingService/ExecuteQuote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tradingServiceClient) SetRiskLimits(ctx context.Context, in *SetRiskLimitsRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/tradingservice_proto.TradingService/SetRiskLimits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TradingServiceServer is the server API for TradingService service.
 // All implementations must embed UnimplementedTradingServiceServer
 // for forward compatibility
 type TradingServiceServer interface {
 	OpenPosition(context.Context, *OpenPositionRequest) (*OpenPositionResponse, error)
 	ClosePosition(context.Context, *ClosePositionRequest) (*Response, error)
+	IncreasePosition(context.Context, *IncreasePositionRequest) (*IncreasePositionResponse, error)
 	GetPositionByID(context.Context, *GetPositionByIDRequest) (*GetPositionByIDResponse, error)
 	GetUserPositions(context.Context, *GetUserPositionsRequest) (*GetUserPositionsResponse, error)
+	ListPositions(context.Context, *ListPositionsRequest) (*ListPositionsResponse, error)
+	GetPortfolioSummary(context.Context, *GetPortfolioSummaryRequest) (*GetPortfolioSummaryResponse, error)
 	StopLoss(context.Context, *StopLossRequest) (*Response, error)
 	TakeProfit(context.Context, *TakeProfitRequest) (*Response, error)
+	TrailingStop(context.Context, *TrailingStopRequest) (*Response, error)
+	PlaceLimitOrder(context.Context, *PlaceLimitOrderRequest) (*PlaceLimitOrderResponse, error)
+	CancelOrder(context.Context, *CancelOrderRequest) (*Response, error)
+	GetUserOrders(context.Context, *GetUserOrdersRequest) (*GetUserOrdersResponse, error)
+	StreamPortfolio(*StreamPortfolioRequest, TradingService_StreamPortfolioServer) error
+	SubscribeEvents(*SubscribeEventsRequest, TradingService_SubscribeEventsServer) error
+	SetCommissionSchedule(context.Context, *SetCommissionScheduleRequest) (*Response, error)
+	SetUserTier(context.Context, *SetUserTierRequest) (*Response, error)
+	SetFinancingRate(context.Context, *SetFinancingRateRequest) (*Response, error)
+	RequestQuote(context.Context, *RequestQuoteRequest) (*RequestQuoteResponse, error)
+	ExecuteQuote(context.Context, *ExecuteQuoteRequest) (*ExecuteQuoteResponse, error)
+	SetRiskLimits(context.Context, *SetRiskLimitsRequest) (*Response, error)
 	mustEmbedUnimplementedTradingServiceServer()
 }
 
@@ -115,18 +326,63 @@ func (UnimplementedTradingServiceServer) OpenPosition(context.Context, *OpenPosi
 func (UnimplementedTradingServiceServer) ClosePosition(context.Context, *ClosePositionRequest) (*Response, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ClosePosition not implemented")
 }
+func (UnimplementedTradingServiceServer) IncreasePosition(context.Context, *IncreasePositionRequest) (*IncreasePositionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IncreasePosition not implemented")
+}
 func (UnimplementedTradingServiceServer) GetPositionByID(context.Context, *GetPositionByIDRequest) (*GetPositionByIDResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPositionByID not implemented")
 }
 func (UnimplementedTradingServiceServer) GetUserPositions(context.Context, *GetUserPositionsRequest) (*GetUserPositionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetUserPositions not implemented")
 }
+func (UnimplementedTradingServiceServer) ListPositions(context.Context, *ListPositionsRequest) (*ListPositionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPositions not implemented")
+}
+func (UnimplementedTradingServiceServer) GetPortfolioSummary(context.Context, *GetPortfolioSummaryRequest) (*GetPortfolioSummaryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPortfolioSummary not implemented")
+}
 func (UnimplementedTradingServiceServer) StopLoss(context.Context, *StopLossRequest) (*Response, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StopLoss not implemented")
 }
 func (UnimplementedTradingServiceServer) TakeProfit(context.Context, *TakeProfitRequest) (*Response, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TakeProfit not implemented")
 }
+func (UnimplementedTradingServiceServer) TrailingStop(context.Context, *TrailingStopRequest) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TrailingStop not implemented")
+}
+func (UnimplementedTradingServiceServer) PlaceLimitOrder(context.Context, *PlaceLimitOrderRequest) (*PlaceLimitOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlaceLimitOrder not implemented")
+}
+func (UnimplementedTradingServiceServer) CancelOrder(context.Context, *CancelOrderRequest) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelOrder not implemented")
+}
+func (UnimplementedTradingServiceServer) GetUserOrders(context.Context, *GetUserOrdersRequest) (*GetUserOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserOrders not implemented")
+}
+func (UnimplementedTradingServiceServer) StreamPortfolio(*StreamPortfolioRequest, TradingService_StreamPortfolioServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamPortfolio not implemented")
+}
+func (UnimplementedTradingServiceServer) SubscribeEvents(*SubscribeEventsRequest, TradingService_SubscribeEventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeEvents not implemented")
+}
+func (UnimplementedTradingServiceServer) SetCommissionSchedule(context.Context, *SetCommissionScheduleRequest) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCommissionSchedule not implemented")
+}
+func (UnimplementedTradingServiceServer) SetUserTier(context.Context, *SetUserTierRequest) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetUserTier not implemented")
+}
+func (UnimplementedTradingServiceServer) SetFinancingRate(context.Context, *SetFinancingRateRequest) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFinancingRate not implemented")
+}
+func (UnimplementedTradingServiceServer) RequestQuote(context.Context, *RequestQuoteRequest) (*RequestQuoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestQuote not implemented")
+}
+func (UnimplementedTradingServiceServer) ExecuteQuote(context.Context, *ExecuteQuoteRequest) (*ExecuteQuoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExecuteQuote not implemented")
+}
+func (UnimplementedTradingServiceServer) SetRiskLimits(context.Context, *SetRiskLimitsRequest) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRiskLimits not implemented")
+}
 func (UnimplementedTradingServiceServer) mustEmbedUnimplementedTradingServiceServer() {}
 
 // UnsafeTradingServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -176,6 +432,24 @@ func _TradingService_ClosePosition_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TradingService_IncreasePosition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IncreasePositionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TradingServiceServer).IncreasePosition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tradingservice_proto.TradingService/IncreasePosition",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TradingServiceServer).IncreasePosition(ctx, req.(*IncreasePositionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _TradingService_GetPositionByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetPositionByIDRequest)
 	if err := dec(in); err != nil {
@@ -212,6 +486,42 @@ func _TradingService_GetUserPositions_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TradingService_ListPositions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPositionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TradingServiceServer).ListPositions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tradingservice_proto.TradingService/ListPositions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TradingServiceServer).ListPositions(ctx, req.(*ListPositionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TradingService_GetPortfolioSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPortfolioSummaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TradingServiceServer).GetPortfolioSummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tradingservice_proto.TradingService/GetPortfolioSummary",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TradingServiceServer).GetPortfolioSummary(ctx, req.(*GetPortfolioSummaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _TradingService_StopLoss_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(StopLossRequest)
 	if err := dec(in); err != nil {
@@ -248,6 +558,228 @@ func _TradingService_TakeProfit_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TradingService_TrailingStop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TrailingStopRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TradingServiceServer).TrailingStop(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tradingservice_proto.TradingService/TrailingStop",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TradingServiceServer).TrailingStop(ctx, req.(*TrailingStopRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TradingService_PlaceLimitOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlaceLimitOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TradingServiceServer).PlaceLimitOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tradingservice_proto.TradingService/PlaceLimitOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TradingServiceServer).PlaceLimitOrder(ctx, req.(*PlaceLimitOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TradingService_CancelOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TradingServiceServer).CancelOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tradingservice_proto.TradingService/CancelOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TradingServiceServer).CancelOrder(ctx, req.(*CancelOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TradingService_GetUserOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TradingServiceServer).GetUserOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tradingservice_proto.TradingService/GetUserOrders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TradingServiceServer).GetUserOrders(ctx, req.(*GetUserOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TradingService_StreamPortfolio_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamPortfolioRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TradingServiceServer).StreamPortfolio(m, &tradingServiceStreamPortfolioServer{stream})
+}
+
+type TradingService_StreamPortfolioServer interface {
+	Send(*StreamPortfolioResponse) error
+	grpc.ServerStream
+}
+
+type tradingServiceStreamPortfolioServer struct {
+	grpc.ServerStream
+}
+
+func (x *tradingServiceStreamPortfolioServer) Send(m *StreamPortfolioResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _TradingService_SubscribeEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TradingServiceServer).SubscribeEvents(m, &tradingServiceSubscribeEventsServer{stream})
+}
+
+type TradingService_SubscribeEventsServer interface {
+	Send(*Event) error
+	grpc.ServerStream
+}
+
+type tradingServiceSubscribeEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *tradingServiceSubscribeEventsServer) Send(m *Event) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _TradingService_SetCommissionSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetCommissionScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TradingServiceServer).SetCommissionSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tradingservice_proto.TradingService/SetCommissionSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TradingServiceServer).SetCommissionSchedule(ctx, req.(*SetCommissionScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TradingService_SetUserTier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetUserTierRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TradingServiceServer).SetUserTier(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tradingservice_proto.TradingService/SetUserTier",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TradingServiceServer).SetUserTier(ctx, req.(*SetUserTierRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TradingService_SetFinancingRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetFinancingRateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TradingServiceServer).SetFinancingRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tradingservice_proto.TradingService/SetFinancingRate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TradingServiceServer).SetFinancingRate(ctx, req.(*SetFinancingRateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TradingService_RequestQuote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestQuoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TradingServiceServer).RequestQuote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tradingservice_proto.TradingService/RequestQuote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TradingServiceServer).RequestQuote(ctx, req.(*RequestQuoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TradingService_ExecuteQuote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExecuteQuoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TradingServiceServer).ExecuteQuote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tradingservice_proto.TradingService/ExecuteQuote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TradingServiceServer).ExecuteQuote(ctx, req.(*ExecuteQuoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TradingService_SetRiskLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetRiskLimitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TradingServiceServer).SetRiskLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tradingservice_proto.TradingService/SetRiskLimits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TradingServiceServer).SetRiskLimits(ctx, req.(*SetRiskLimitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // TradingService_ServiceDesc is the grpc.ServiceDesc for TradingService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -263,6 +795,10 @@ var TradingService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ClosePosition",
 			Handler:    _TradingService_ClosePosition_Handler,
 		},
+		{
+			MethodName: "IncreasePosition",
+			Handler:    _TradingService_IncreasePosition_Handler,
+		},
 		{
 			MethodName: "GetPositionByID",
 			Handler:    _TradingService_GetPositionByID_Handler,
@@ -271,6 +807,14 @@ var TradingService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetUserPositions",
 			Handler:    _TradingService_GetUserPositions_Handler,
 		},
+		{
+			MethodName: "ListPositions",
+			Handler:    _TradingService_ListPositions_Handler,
+		},
+		{
+			MethodName: "GetPortfolioSummary",
+			Handler:    _TradingService_GetPortfolioSummary_Handler,
+		},
 		{
 			MethodName: "StopLoss",
 			Handler:    _TradingService_StopLoss_Handler,
@@ -279,7 +823,58 @@ var TradingService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "TakeProfit",
 			Handler:    _TradingService_TakeProfit_Handler,
 		},
+		{
+			MethodName: "TrailingStop",
+			Handler:    _TradingService_TrailingStop_Handler,
+		},
+		{
+			MethodName: "PlaceLimitOrder",
+			Handler:    _TradingService_PlaceLimitOrder_Handler,
+		},
+		{
+			MethodName: "CancelOrder",
+			Handler:    _TradingService_CancelOrder_Handler,
+		},
+		{
+			MethodName: "GetUserOrders",
+			Handler:    _TradingService_GetUserOrders_Handler,
+		},
+		{
+			MethodName: "SetCommissionSchedule",
+			Handler:    _TradingService_SetCommissionSchedule_Handler,
+		},
+		{
+			MethodName: "SetUserTier",
+			Handler:    _TradingService_SetUserTier_Handler,
+		},
+		{
+			MethodName: "SetFinancingRate",
+			Handler:    _TradingService_SetFinancingRate_Handler,
+		},
+		{
+			MethodName: "RequestQuote",
+			Handler:    _TradingService_RequestQuote_Handler,
+		},
+		{
+			MethodName: "ExecuteQuote",
+			Handler:    _TradingService_ExecuteQuote_Handler,
+		},
+		{
+			MethodName: "SetRiskLimits",
+			Handler:    _TradingService_SetRiskLimits_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamPortfolio",
+			Handler:       _TradingService_StreamPortfolio_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SubscribeEvents",
+			Handler:       _TradingService_SubscribeEvents_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/tradingModel.proto",
 }